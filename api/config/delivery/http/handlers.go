@@ -2,9 +2,12 @@ package http
 
 import (
 	"bytes"
+	"crypto/subtle"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 
 	"github.com/labstack/echo/v4"
 
@@ -12,6 +15,12 @@ import (
 	"github.com/monitoror/monitoror/api/config/models"
 )
 
+const (
+	// AuthorContextKey is the context key of the author authenticated by WriteAuthMiddleware. Stored in config revisions
+	AuthorContextKey = "configAuthor"
+	DefaultAuthor    = "anonymous"
+)
+
 type ConfigDelivery struct {
 	configUsecase config.Usecase
 }
@@ -35,6 +44,57 @@ func (h *ConfigDelivery) GetConfig(c echo.Context) error {
 
 	configBag := h.configUsecase.GetConfig(params)
 
+	return h.replyConfig(c, configBag)
+}
+
+func (h *ConfigDelivery) VerifyConfig(c echo.Context) error {
+	configBag := h.configUsecase.ReadConfig(c.Request().Body)
+
+	return h.replyConfig(c, configBag)
+}
+
+func (h *ConfigDelivery) GetConfigRevisions(c echo.Context) error {
+	params := &models.ConfigParams{Config: c.Param("config")}
+
+	configBag := h.configUsecase.GetConfigRevisions(params)
+
+	return replyConfigBag(c, configBag)
+}
+
+func (h *ConfigDelivery) SaveConfig(c echo.Context) error {
+	// Body contains config, don't bind it into params
+	params := &models.ConfigParams{Config: c.Param("config")}
+
+	configBag := h.configUsecase.SaveConfig(params, author(c), c.Request().Body)
+
+	return replyConfigBag(c, configBag)
+}
+
+func (h *ConfigDelivery) RollbackConfig(c echo.Context) error {
+	revision, err := strconv.Atoi(c.Param("revision"))
+	if err != nil || revision <= 0 {
+		return echo.ErrBadRequest
+	}
+	params := &models.ConfigRevisionParams{Config: c.Param("config"), Revision: revision}
+
+	configBag := h.configUsecase.RollbackConfig(params, author(c))
+
+	return replyConfigBag(c, configBag)
+}
+
+func (h *ConfigDelivery) DeleteConfig(c echo.Context) error {
+	params := &models.ConfigParams{Config: c.Param("config")}
+
+	configBag := h.configUsecase.DeleteConfig(params)
+	if len(configBag.Errors) == 0 {
+		return c.NoContent(http.StatusNoContent)
+	}
+
+	return replyConfigBag(c, configBag)
+}
+
+// replyConfig verify and hydrate configBag before sending it
+func (h *ConfigDelivery) replyConfig(c echo.Context, configBag *models.ConfigBag) error {
 	if len(configBag.Errors) == 0 {
 		h.configUsecase.Verify(configBag)
 	}
@@ -49,6 +109,56 @@ func (h *ConfigDelivery) GetConfig(c echo.Context) error {
 	return c.Blob(http.StatusOK, echo.MIMEApplicationJSONCharsetUTF8, encoded)
 }
 
+// replyConfigBag send configBag of config store endpoints with status based on its first error
+func replyConfigBag(c echo.Context, configBag *models.ConfigBag) error {
+	status := http.StatusOK
+	if len(configBag.Errors) > 0 {
+		switch configBag.Errors[0].ID {
+		case models.ConfigErrorUnknownNamedConfig, models.ConfigErrorUnknownRevision:
+			status = http.StatusNotFound
+		case models.ConfigErrorReadOnlyNamedConfig:
+			status = http.StatusForbidden
+		case models.ConfigErrorUnexpectedError:
+			status = http.StatusInternalServerError
+		default:
+			status = http.StatusBadRequest
+		}
+	}
+
+	encoded, _ := JSONMarshal(configBag) // Ignoring error, assuming there is no function or channel inside this struct
+
+	return c.Blob(status, echo.MIMEApplicationJSONCharsetUTF8, encoded)
+}
+
+// WriteAuthMiddleware only accept requests with a Bearer token of tokens (author by token), the author is set in context
+func WriteAuthMiddleware(tokens map[string]string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			authorization := []byte(c.Request().Header.Get(echo.HeaderAuthorization))
+
+			author := ""
+			for token, tokenAuthor := range tokens {
+				if subtle.ConstantTimeCompare(authorization, []byte(fmt.Sprintf("Bearer %s", token))) == 1 {
+					author = tokenAuthor
+				}
+			}
+			if author == "" {
+				return echo.ErrUnauthorized
+			}
+
+			c.Set(AuthorContextKey, author)
+			return next(c)
+		}
+	}
+}
+
+func author(c echo.Context) string {
+	if author, ok := c.Get(AuthorContextKey).(string); ok && author != "" {
+		return author
+	}
+	return DefaultAuthor
+}
+
 // JSONMarshal same as JSON.Marshall but with SetEscapeHTML(false)
 func JSONMarshal(t interface{}) ([]byte, error) {
 	buffer := &bytes.Buffer{}
@@ -131,3 +131,199 @@ func TestDelivery_ConfigHandler_ErrorHydrate(t *testing.T) {
 		mockUsecase.AssertExpectations(t)
 	}
 }
+
+func TestDelivery_VerifyConfigHandler_Success(t *testing.T) {
+	// Init
+	e := echo.New()
+	req := httptest.NewRequest(echo.POST, "/api/v1/configs/verify", strings.NewReader(`{"version":"2.1"}`))
+	res := httptest.NewRecorder()
+	ctx := e.NewContext(req, res)
+
+	config := &models.ConfigBag{}
+
+	mockUsecase := new(mocks.Usecase)
+	mockUsecase.On("ReadConfig", Anything).Return(config)
+	mockUsecase.On("Verify", Anything)
+	mockUsecase.On("Hydrate", Anything, Anything)
+	handler := NewConfigDelivery(mockUsecase)
+
+	// Expected
+	json, err := json.Marshal(config)
+	assert.NoError(t, err, "unable to marshal config")
+
+	// Test
+	if assert.NoError(t, handler.VerifyConfig(ctx)) {
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(json), strings.TrimSpace(res.Body.String()))
+		mockUsecase.AssertNumberOfCalls(t, "ReadConfig", 1)
+		mockUsecase.AssertNumberOfCalls(t, "Verify", 1)
+		mockUsecase.AssertNumberOfCalls(t, "Hydrate", 1)
+		mockUsecase.AssertExpectations(t)
+	}
+}
+
+func TestDelivery_VerifyConfigHandler_ErrorRead(t *testing.T) {
+	// Init
+	e := echo.New()
+	req := httptest.NewRequest(echo.POST, "/api/v1/configs/verify", strings.NewReader(`{`))
+	res := httptest.NewRecorder()
+	ctx := e.NewContext(req, res)
+
+	conf := &models.ConfigBag{}
+	conf.AddErrors(models.ConfigError{ID: models.ConfigErrorUnableToParseConfig, Message: "boom"})
+
+	mockUsecase := new(mocks.Usecase)
+	mockUsecase.On("ReadConfig", Anything).Return(conf)
+	handler := NewConfigDelivery(mockUsecase)
+
+	// Test
+	if assert.NoError(t, handler.VerifyConfig(ctx)) {
+		strConf, _ := json.Marshal(conf)
+		assert.Equal(t, string(strConf), strings.TrimSpace(res.Body.String()))
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		mockUsecase.AssertNumberOfCalls(t, "ReadConfig", 1)
+		mockUsecase.AssertNotCalled(t, "Verify", Anything)
+		mockUsecase.AssertExpectations(t)
+	}
+}
+
+func TestDelivery_SaveConfigHandler(t *testing.T) {
+	for _, testcase := range []struct {
+		configBag      *models.ConfigBag
+		expectedStatus int
+	}{
+		{configBag: &models.ConfigBag{Revision: &models.ConfigRevision{Revision: 1, Author: "test"}}, expectedStatus: http.StatusOK},
+		{configBag: &models.ConfigBag{Errors: []models.ConfigError{{ID: models.ConfigErrorUnknownTileType}}}, expectedStatus: http.StatusBadRequest},
+		{configBag: &models.ConfigBag{Errors: []models.ConfigError{{ID: models.ConfigErrorReadOnlyNamedConfig}}}, expectedStatus: http.StatusForbidden},
+		{configBag: &models.ConfigBag{Errors: []models.ConfigError{{ID: models.ConfigErrorUnexpectedError}}}, expectedStatus: http.StatusInternalServerError},
+	} {
+		// Init
+		e := echo.New()
+		req := httptest.NewRequest(echo.PUT, "/api/v1/configs/screen1", strings.NewReader(`{"version":"2.1"}`))
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+		ctx.Set(AuthorContextKey, "test")
+		ctx.SetParamNames("config")
+		ctx.SetParamValues("screen1")
+
+		mockUsecase := new(mocks.Usecase)
+		mockUsecase.On("SaveConfig", &models.ConfigParams{Config: "screen1"}, "test", Anything).Return(testcase.configBag)
+		handler := NewConfigDelivery(mockUsecase)
+
+		// Expected
+		json, err := json.Marshal(testcase.configBag)
+		assert.NoError(t, err, "unable to marshal config")
+
+		// Test
+		if assert.NoError(t, handler.SaveConfig(ctx)) {
+			assert.Equal(t, testcase.expectedStatus, res.Code)
+			assert.Equal(t, string(json), strings.TrimSpace(res.Body.String()))
+			mockUsecase.AssertExpectations(t)
+		}
+	}
+}
+
+func TestDelivery_DeleteConfigHandler(t *testing.T) {
+	// Init
+	ctx, res := initEcho()
+	ctx.SetParamNames("config")
+	ctx.SetParamValues("screen1")
+
+	mockUsecase := new(mocks.Usecase)
+	mockUsecase.On("DeleteConfig", &models.ConfigParams{Config: "screen1"}).Return(&models.ConfigBag{})
+	handler := NewConfigDelivery(mockUsecase)
+
+	// Test
+	if assert.NoError(t, handler.DeleteConfig(ctx)) {
+		assert.Equal(t, http.StatusNoContent, res.Code)
+		mockUsecase.AssertExpectations(t)
+	}
+}
+
+func TestDelivery_GetConfigRevisionsHandler(t *testing.T) {
+	// Init
+	ctx, res := initEcho()
+	ctx.SetParamNames("config")
+	ctx.SetParamValues("screen1")
+
+	configBag := &models.ConfigBag{Errors: []models.ConfigError{{ID: models.ConfigErrorUnknownNamedConfig}}}
+
+	mockUsecase := new(mocks.Usecase)
+	mockUsecase.On("GetConfigRevisions", &models.ConfigParams{Config: "screen1"}).Return(configBag)
+	handler := NewConfigDelivery(mockUsecase)
+
+	// Test
+	if assert.NoError(t, handler.GetConfigRevisions(ctx)) {
+		assert.Equal(t, http.StatusNotFound, res.Code)
+		mockUsecase.AssertExpectations(t)
+	}
+}
+
+func TestDelivery_RollbackConfigHandler(t *testing.T) {
+	// Init
+	ctx, res := initEcho()
+	ctx.SetParamNames("config", "revision")
+	ctx.SetParamValues("screen1", "2")
+
+	configBag := &models.ConfigBag{Revision: &models.ConfigRevision{Revision: 3, Author: DefaultAuthor}}
+
+	mockUsecase := new(mocks.Usecase)
+	mockUsecase.On("RollbackConfig", &models.ConfigRevisionParams{Config: "screen1", Revision: 2}, DefaultAuthor).Return(configBag)
+	handler := NewConfigDelivery(mockUsecase)
+
+	// Test
+	if assert.NoError(t, handler.RollbackConfig(ctx)) {
+		assert.Equal(t, http.StatusOK, res.Code)
+		mockUsecase.AssertExpectations(t)
+	}
+}
+
+func TestDelivery_RollbackConfigHandler_InvalidRevision(t *testing.T) {
+	// Init
+	ctx, _ := initEcho()
+	ctx.SetParamNames("config", "revision")
+	ctx.SetParamValues("screen1", "last")
+
+	mockUsecase := new(mocks.Usecase)
+	handler := NewConfigDelivery(mockUsecase)
+
+	// Test
+	assert.Equal(t, echo.ErrBadRequest, handler.RollbackConfig(ctx))
+	mockUsecase.AssertNotCalled(t, "RollbackConfig", Anything, Anything)
+}
+
+func TestDelivery_WriteAuthMiddleware(t *testing.T) {
+	middleware := WriteAuthMiddleware(map[string]string{"s3cr3t": "alice", "t0ken": "ci"})
+
+	for _, testcase := range []struct {
+		authorization  string
+		expectedAuthor string
+	}{
+		{authorization: "Bearer s3cr3t", expectedAuthor: "alice"},
+		{authorization: "Bearer t0ken", expectedAuthor: "ci"},
+		{authorization: "Bearer wrong"},
+		{authorization: "s3cr3t"},
+		{authorization: ""},
+	} {
+		ctx, _ := initEcho()
+		ctx.Request().Header.Set(echo.HeaderAuthorization, testcase.authorization)
+
+		var author string
+		err := middleware(func(c echo.Context) error {
+			author = c.Get(AuthorContextKey).(string)
+			return nil
+		})(ctx)
+
+		if testcase.expectedAuthor == "" {
+			assert.Equal(t, echo.ErrUnauthorized, err)
+		} else if assert.NoError(t, err) {
+			assert.Equal(t, testcase.expectedAuthor, author)
+		}
+	}
+
+	// Without tokens, nothing is accepted
+	ctx, _ := initEcho()
+	ctx.Request().Header.Set(echo.HeaderAuthorization, "Bearer ")
+	assert.Equal(t, echo.ErrUnauthorized, WriteAuthMiddleware(map[string]string{})(func(c echo.Context) error { return nil })(ctx))
+}
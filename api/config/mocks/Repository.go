@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	io "io"
+
 	models "github.com/monitoror/monitoror/api/config/models"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -35,6 +37,29 @@ func (_m *Repository) GetConfigFromPath(baseDir string, filePath string) (*model
 	return r0, r1
 }
 
+// GetConfigFromReader provides a mock function with given fields: reader
+func (_m *Repository) GetConfigFromReader(reader io.Reader) (*models.Config, error) {
+	ret := _m.Called(reader)
+
+	var r0 *models.Config
+	if rf, ok := ret.Get(0).(func(io.Reader) *models.Config); ok {
+		r0 = rf(reader)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Config)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(io.Reader) error); ok {
+		r1 = rf(reader)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetConfigFromURL provides a mock function with given fields: url
 func (_m *Repository) GetConfigFromURL(url string) (*models.Config, error) {
 	ret := _m.Called(url)
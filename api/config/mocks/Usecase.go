@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	io "io"
+
 	models "github.com/monitoror/monitoror/api/config/models"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,6 +14,22 @@ type Usecase struct {
 	mock.Mock
 }
 
+// DeleteConfig provides a mock function with given fields: params
+func (_m *Usecase) DeleteConfig(params *models.ConfigParams) *models.ConfigBag {
+	ret := _m.Called(params)
+
+	var r0 *models.ConfigBag
+	if rf, ok := ret.Get(0).(func(*models.ConfigParams) *models.ConfigBag); ok {
+		r0 = rf(params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ConfigBag)
+		}
+	}
+
+	return r0
+}
+
 // GetConfig provides a mock function with given fields: params
 func (_m *Usecase) GetConfig(params *models.ConfigParams) *models.ConfigBag {
 	ret := _m.Called(params)
@@ -44,11 +62,75 @@ func (_m *Usecase) GetConfigList() []models.ConfigMetadata {
 	return r0
 }
 
+// GetConfigRevisions provides a mock function with given fields: params
+func (_m *Usecase) GetConfigRevisions(params *models.ConfigParams) *models.ConfigBag {
+	ret := _m.Called(params)
+
+	var r0 *models.ConfigBag
+	if rf, ok := ret.Get(0).(func(*models.ConfigParams) *models.ConfigBag); ok {
+		r0 = rf(params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ConfigBag)
+		}
+	}
+
+	return r0
+}
+
 // Hydrate provides a mock function with given fields: _a0
 func (_m *Usecase) Hydrate(_a0 *models.ConfigBag) {
 	_m.Called(_a0)
 }
 
+// ReadConfig provides a mock function with given fields: reader
+func (_m *Usecase) ReadConfig(reader io.Reader) *models.ConfigBag {
+	ret := _m.Called(reader)
+
+	var r0 *models.ConfigBag
+	if rf, ok := ret.Get(0).(func(io.Reader) *models.ConfigBag); ok {
+		r0 = rf(reader)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ConfigBag)
+		}
+	}
+
+	return r0
+}
+
+// RollbackConfig provides a mock function with given fields: params, author
+func (_m *Usecase) RollbackConfig(params *models.ConfigRevisionParams, author string) *models.ConfigBag {
+	ret := _m.Called(params, author)
+
+	var r0 *models.ConfigBag
+	if rf, ok := ret.Get(0).(func(*models.ConfigRevisionParams, string) *models.ConfigBag); ok {
+		r0 = rf(params, author)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ConfigBag)
+		}
+	}
+
+	return r0
+}
+
+// SaveConfig provides a mock function with given fields: params, author, reader
+func (_m *Usecase) SaveConfig(params *models.ConfigParams, author string, reader io.Reader) *models.ConfigBag {
+	ret := _m.Called(params, author, reader)
+
+	var r0 *models.ConfigBag
+	if rf, ok := ret.Get(0).(func(*models.ConfigParams, string, io.Reader) *models.ConfigBag); ok {
+		r0 = rf(params, author, reader)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ConfigBag)
+		}
+	}
+
+	return r0
+}
+
 // Verify provides a mock function with given fields: _a0
 func (_m *Usecase) Verify(_a0 *models.ConfigBag) {
 	_m.Called(_a0)
@@ -7,8 +7,10 @@ import (
 
 type (
 	ConfigBag struct {
-		Config *Config       `json:"config,omitempty"`
-		Errors []ConfigError `json:"errors,omitempty"`
+		Config    *Config           `json:"config,omitempty"`
+		Revision  *ConfigRevision   `json:"revision,omitempty"`
+		Revisions []*ConfigRevision `json:"revisions,omitempty"`
+		Errors    []ConfigError     `json:"errors,omitempty"`
 	}
 
 	Config struct {
@@ -29,10 +31,48 @@ type (
 		URL             string       `json:"url,omitempty"`
 		InitialMaxDelay *int         `json:"initialMaxDelay,omitempty"`
 
+		// In Millisecond, bounded by server config. CacheExpiration is moved into URL during hydration
+		RefreshInterval *int `json:"refreshInterval,omitempty" validate:"omitempty,gt=0"`
+		CacheExpiration *int `json:"cacheExpiration,omitempty" validate:"omitempty,gt=0"`
+
+		// Only on GROUP tile. Status of the group is computed by the core on GroupStatusRoutePath
+		Aggregate GroupAggregate `json:"aggregate,omitempty" validate:"omitempty,oneof=WORST MAJORITY FAILURES"`
+
+		// Only on generator tile. Verified in verifyGeneratorOptions
+		Generator *GeneratorOptions `json:"generator,omitempty" validate:"-"`
+
+		// Change status of tile according its value. Moved into URL during hydration
+		Thresholds *Thresholds `json:"thresholds,omitempty" validate:"-"`
+		// Keep past values of tile. Moved into URL during hydration
+		History *HistoryOptions `json:"history,omitempty" validate:"-"`
+
 		// Used to validate config and to create API URLs
 		// Will be removed before being returned to the UI
 		Params        map[string]interface{} `json:"params,omitempty"`
-		ConfigVariant coreModels.VariantName `json:"configVariant,omitempty"`
+		ConfigVariant coreModels.VariantName `json:"variant,omitempty"`
+	}
+
+	// GeneratorOptions generic options applied on generated tiles, whatever the monitorable
+	GeneratorOptions struct {
+		// Include / Exclude generated tiles when regex match "label" or a params field
+		Include map[string]string `json:"include,omitempty"`
+		Exclude map[string]string `json:"exclude,omitempty"`
+
+		SortBy        string `json:"sortBy,omitempty" validate:"omitempty,oneof=label"`
+		Limit         *int   `json:"limit,omitempty" validate:"omitempty,gt=0"`
+		LabelTemplate string `json:"labelTemplate,omitempty"` // text/template using "label" and params fields
+	}
+
+	// Thresholds evaluated by the core on the last value of tile (see threshold.Parse)
+	Thresholds struct {
+		Warning string `json:"warning,omitempty"`
+		Failure string `json:"failure,omitempty"`
+	}
+
+	// HistoryOptions define the rolling window of past values returned by tile. Size is bounded by server config
+	HistoryOptions struct {
+		Size     int  `json:"size" validate:"required,gt=0"`
+		Interval *int `json:"interval,omitempty" validate:"omitempty,gt=0"` // In Millisecond, minimum duration between two values
 	}
 
 	ConfigError struct {
@@ -50,15 +90,27 @@ type (
 	}
 
 	ConfigErrorID string
+
+	// GroupAggregate define how the status of a GROUP tile is computed from its sub-tiles
+	GroupAggregate string
+)
+
+const (
+	WorstGroupAggregate    GroupAggregate = "WORST"    // Most important status of sub-tiles
+	MajorityGroupAggregate GroupAggregate = "MAJORITY" // Most common status of sub-tiles
+	FailuresGroupAggregate GroupAggregate = "FAILURES" // FAILURE when at least one sub-tile is failing
 )
 
 const (
 	ConfigErrorConfigNotFound                    ConfigErrorID = "ERROR_CONFIG_NOT_FOUND"
+	ConfigErrorDeprecatedField                   ConfigErrorID = "ERROR_DEPRECATED_FIELD"
 	ConfigErrorDisabledVariant                   ConfigErrorID = "ERROR_DISABLED_VARIANT"
 	ConfigErrorFieldTypeMismatch                 ConfigErrorID = "ERROR_FIELD_TYPE_MISMATCH"
 	ConfigErrorInvalidEscapedCharacter           ConfigErrorID = "ERROR_INVALID_ESCAPED_CHARACTER"
 	ConfigErrorInvalidFieldValue                 ConfigErrorID = "ERROR_INVALID_FIELD_VALUE"
+	ConfigErrorInvalidNamedConfig                ConfigErrorID = "ERROR_INVALID_NAMED_CONFIG"
 	ConfigErrorMissingRequiredField              ConfigErrorID = "ERROR_MISSING_REQUIRED_FIELD"
+	ConfigErrorReadOnlyNamedConfig               ConfigErrorID = "ERROR_READ_ONLY_NAMED_CONFIG"
 	ConfigErrorUnsupportedTileInThisVersion      ConfigErrorID = "ERROR_UNSUPPORTED_TILE_IN_THIS_VERSION"
 	ConfigErrorUnsupportedTileParamInThisVersion ConfigErrorID = "ERROR_UNSUPPORTED_TILE_PARAM_IN_THIS_VERSION"
 	ConfigErrorUnauthorizedField                 ConfigErrorID = "ERROR_UNAUTHORIZED_FIELD"
@@ -69,6 +121,7 @@ const (
 	ConfigErrorUnknownField                      ConfigErrorID = "ERROR_UNKNOWN_FIELD"
 	ConfigErrorUnknownGeneratorTileType          ConfigErrorID = "ERROR_UNKNOWN_GENERATOR_TILE_TYPE"
 	ConfigErrorUnknownNamedConfig                ConfigErrorID = "ERROR_UNKNOWN_NAMED_CONFIG"
+	ConfigErrorUnknownRevision                   ConfigErrorID = "ERROR_UNKNOWN_REVISION"
 	ConfigErrorUnknownTileType                   ConfigErrorID = "ERROR_UNKNOWN_TILE_TYPE"
 	ConfigErrorUnknownVariant                    ConfigErrorID = "ERROR_UNKNOWN_VARIANT"
 	ConfigErrorUnsupportedVersion                ConfigErrorID = "ERROR_UNSUPPORTED_VERSION"
@@ -3,6 +3,8 @@ package models
 import (
 	"bytes"
 	"encoding/json"
+
+	"github.com/monitoror/monitoror/api/config/versions"
 )
 
 // The goal here is to raise an error if a key is sent that is not supported.
@@ -11,13 +13,38 @@ type TempConfig Config // Use Temp config to avoid infinite UnmarshalJSON loop
 
 // UnmarshalJSON should error if there is something unexpected
 func (c *Config) UnmarshalJSON(data []byte) error {
+	// Upgrade config written in a previous version before parsing it
+	data = migrate(data)
+
 	var tc TempConfig
 	dec := json.NewDecoder(bytes.NewReader(data))
 	dec.DisallowUnknownFields() // Force
-
 	if err := dec.Decode(&tc); err != nil {
 		return err
 	}
+
 	*c = Config(tc)
 	return nil
 }
+
+// migrate apply versions.Migrate on raw config. Return data unchanged if nothing was migrated
+func migrate(data []byte) []byte {
+	var rawConfig map[string]interface{}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber() // Keep numbers as they are written
+	if err := dec.Decode(&rawConfig); err != nil {
+		return data
+	}
+
+	if migrations := versions.Migrate(rawConfig); len(migrations) == 0 {
+		return data
+	}
+
+	migratedData, err := json.Marshal(rawConfig)
+	if err != nil {
+		return data
+	}
+
+	return migratedData
+}
@@ -4,6 +4,9 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/monitoror/monitoror/api/config/versions"
+	coreModels "github.com/monitoror/monitoror/models"
+
 	"github.com/stretchr/testify/assert"
 )
 
@@ -18,3 +21,19 @@ func TestConfig_UnmarshalJSON(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, `json: unknown field "test"`, err.Error())
 }
+
+func TestConfig_UnmarshalJSON_Migration(t *testing.T) {
+	test := &Config{}
+	input := `{"version": "2.0", "columns": 2, "tiles": [{"type": "PING", "configVariant": "test", "params": {"port": 8080}}]}`
+	err := json.Unmarshal([]byte(input), test)
+	if assert.NoError(t, err) {
+		assert.Equal(t, versions.Version2001, test.Version.ToRawVersion())
+		assert.Equal(t, coreModels.VariantName("test"), test.Tiles[0].ConfigVariant)
+		assert.Equal(t, float64(8080), test.Tiles[0].Params["port"])
+	}
+
+	input = `{"version": "2.1", "tiles": [{"type": "PING", "configVariant": "test"}]}`
+	err = json.Unmarshal([]byte(input), test)
+	assert.Error(t, err)
+	assert.Equal(t, `json: unknown field "configVariant"`, err.Error())
+}
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+type (
+	// ConfigRevision describe one version of a config written through the API
+	ConfigRevision struct {
+		Revision  int       `json:"revision"`
+		Author    string    `json:"author"`
+		CreatedAt time.Time `json:"createdAt"`
+	}
+
+	ConfigRevisionParams struct {
+		Config   string `json:"config" query:"config"`
+		Revision int    `json:"revision" query:"revision"`
+	}
+)
@@ -20,7 +20,7 @@ func (e *ConfigFileNotFoundError) Error() string {
 }
 func (e *ConfigFileNotFoundError) Unwrap() error { return e.Err }
 
-//ConfigUnmarshalError
+// ConfigUnmarshalError
 type ConfigUnmarshalError struct {
 	Err       error
 	RawConfig string
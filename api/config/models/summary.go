@@ -0,0 +1,14 @@
+package models
+
+import (
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/params"
+)
+
+type (
+	// SummaryParams params of SUMMARY tile. Sub-tiles are verified and hydrated like any other tiles
+	SummaryParams struct {
+		params.Default
+
+		Tiles []TileConfig `json:"tiles" validate:"required,notempty"`
+	}
+)
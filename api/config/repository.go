@@ -1,8 +1,11 @@
 //go:generate mockery -name Repository
+//go:generate mockery -name StoreRepository
 
 package config
 
 import (
+	"io"
+
 	"github.com/monitoror/monitoror/api/config/models"
 )
 
@@ -10,5 +13,15 @@ type (
 	Repository interface {
 		GetConfigFromURL(url string) (*models.Config, error)
 		GetConfigFromPath(baseDir, filePath string) (*models.Config, error)
+		GetConfigFromReader(reader io.Reader) (*models.Config, error)
+	}
+
+	// StoreRepository keep every revision of configs written through the API
+	StoreRepository interface {
+		GetConfigNames() ([]string, error)
+		GetConfig(name string, revision int) (*models.Config, *models.ConfigRevision, error)
+		GetConfigRevisions(name string) ([]*models.ConfigRevision, error)
+		SaveConfig(name, author string, config *models.Config) (*models.ConfigRevision, error)
+		DeleteConfig(name string) error
 	}
 )
@@ -20,7 +20,7 @@ func TestConfigRepository_GetConfigFromPath(t *testing.T) {
 		defer os.Remove(tmpFile.Name())
 		_, _ = tmpFile.WriteString("{}")
 
-		repository := NewConfigRepository()
+		repository := NewConfigRepository(nil)
 		_, err := repository.GetConfigFromPath("", tmpFile.Name())
 		assert.NoError(t, err)
 	}
@@ -32,7 +32,7 @@ func TestConfigRepository_UnableToParse(t *testing.T) {
 		defer os.Remove(tmpFile.Name())
 		_, _ = tmpFile.WriteString("xxxxxx")
 
-		repository := NewConfigRepository()
+		repository := NewConfigRepository(nil)
 		_, err := repository.GetConfigFromPath("", tmpFile.Name())
 		assert.Error(t, err)
 		assert.Equal(t, "xxxxxx", err.(*models.ConfigUnmarshalError).RawConfig)
@@ -40,7 +40,7 @@ func TestConfigRepository_UnableToParse(t *testing.T) {
 }
 
 func TestConfigRepository_GetConfigFromPath_MissingFile(t *testing.T) {
-	repository := NewConfigRepository()
+	repository := NewConfigRepository(nil)
 	_, err := repository.GetConfigFromPath("/test", "monitoror-missing-file")
 	assert.Error(t, err)
 	assert.Equal(t, "Config not found at: /test/monitoror-missing-file, open /test/monitoror-missing-file: no such file or directory", err.Error())
@@ -1,19 +1,173 @@
 package repository
 
 import (
+	"crypto/tls"
+	"fmt"
+	"io/ioutil"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/monitoror/monitoror/api/config/models"
+	coreConfig "github.com/monitoror/monitoror/config"
+	"github.com/monitoror/monitoror/pkg/certpool"
+	"github.com/monitoror/monitoror/pkg/urlmatch"
+
+	"github.com/sourcegraph/httpcache"
+)
+
+// maxRedirects followed by remote clients, like default http client
+const maxRedirects = 10
+
+type (
+	// remoteClient download configs matching RemoteConfig.URLPrefix
+	remoteClient struct {
+		config     *coreConfig.RemoteConfig
+		headers    http.Header
+		httpClient *http.Client
+
+		// err is returned for every download when client can't be built (ex: invalid CA bundle)
+		err error
+	}
 )
 
-func (cr *configRepository) GetConfigFromURL(url string) (config *models.Config, err error) {
-	resp, err := cr.httpClient.Get(url)
-	if err != nil || resp.StatusCode != http.StatusOK {
+func newRemoteClient(remoteConfig *coreConfig.RemoteConfig) *remoteClient {
+	rc := &remoteClient{config: remoteConfig, headers: parseHeaders(remoteConfig.Headers)}
+
+	// Credentials without prefix would never be sent, fail downloads instead of using them unauthenticated
+	rc.err = remoteConfig.Validate()
+
+	tlsConfig := &tls.Config{InsecureSkipVerify: !remoteConfig.SSLVerify}
+	if remoteConfig.CACert != "" && rc.err == nil {
+		tlsConfig.RootCAs, rc.err = certpool.Load(remoteConfig.CACert)
+	}
+
+	rc.httpClient = &http.Client{
+		// Use cache transport to revalidate config with ETag / Last-Modified instead of downloading it again
+		Transport: &httpcache.Transport{
+			Transport:           &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: tlsConfig},
+			Cache:               httpcache.NewMemoryCache(),
+			MarkCachedResponses: true,
+		},
+		CheckRedirect: rc.checkRedirect,
+		Timeout:       time.Duration(remoteConfig.Timeout) * time.Millisecond,
+	}
+
+	return rc
+}
+
+func (cr *configRepository) GetConfigFromURL(url string) (*models.Config, error) {
+	data, err := cr.getRemoteClient(url).download(url)
+	if err != nil {
+		// Remote host is down, use last good config
+		if lastGood, ok := cr.lastGoodConfigs.Get(url); ok && isRemoteUnavailable(err) {
+			return ReadConfig(strings.NewReader(lastGood.(string)))
+		}
 		return nil, &models.ConfigFileNotFoundError{Err: err, PathOrURL: url}
 	}
+
+	config, err := ReadConfig(strings.NewReader(data))
+	if err == nil {
+		cr.lastGoodConfigs.Set(url, data)
+	}
+
+	return config, err
+}
+
+func (cr *configRepository) getRemoteClient(url string) *remoteClient {
+	for _, rc := range cr.remoteClients {
+		if rc.config.URLPrefix == "" || urlmatch.HasPrefix(url, rc.config.URLPrefix) {
+			return rc
+		}
+	}
+	return nil // Unreachable, repository always contains a client without prefix
+}
+
+// hasCredentials return true when credentials can be sent to url. Credentials are only sent to explicit URLPrefix
+func (rc *remoteClient) hasCredentials(url string) bool {
+	return rc.config.URLPrefix != "" && urlmatch.HasPrefix(url, rc.config.URLPrefix)
+}
+
+// checkRedirect remove credentials when redirected outside of URLPrefix
+func (rc *remoteClient) checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxRedirects {
+		return fmt.Errorf("stopped after %d redirects", maxRedirects)
+	}
+
+	if !rc.hasCredentials(req.URL.String()) {
+		for key := range rc.headers {
+			req.Header.Del(key)
+		}
+		req.Header.Del("Authorization")
+	}
+
+	return nil
+}
+
+func (rc *remoteClient) download(url string) (string, error) {
+	if rc.err != nil {
+		return "", rc.err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	if rc.hasCredentials(url) {
+		for key, values := range rc.headers {
+			req.Header[key] = values
+		}
+		if rc.config.Token != "" {
+			req.Header.Set("Authorization", "Bearer "+rc.config.Token)
+		} else if rc.config.Login != "" {
+			req.SetBasicAuth(rc.config.Login, rc.config.Password)
+		}
+	}
+
+	resp, err := rc.httpClient.Do(req)
+	if err != nil {
+		return "", &remoteUnavailableError{err}
+	}
 	defer resp.Body.Close()
 
-	config, err = ReadConfig(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		err = fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		if resp.StatusCode >= http.StatusInternalServerError {
+			err = &remoteUnavailableError{err}
+		}
+		return "", err
+	}
+
+	bytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", &remoteUnavailableError{err}
+	}
 
-	return
+	return string(bytes), nil
+}
+
+// remoteUnavailableError is returned when remote host is down (network error, timeout, 5xx)
+type remoteUnavailableError struct {
+	err error
+}
+
+func (e *remoteUnavailableError) Error() string { return e.err.Error() }
+func (e *remoteUnavailableError) Unwrap() error { return e.err }
+
+func isRemoteUnavailable(err error) bool {
+	_, ok := err.(*remoteUnavailableError)
+	return ok
+}
+
+// parseHeaders parse headers like: "Private-Token: xxx; X-Custom: yyy"
+func parseHeaders(rawHeaders string) http.Header {
+	headers := make(http.Header)
+	for _, rawHeader := range strings.Split(rawHeaders, ";") {
+		splitHeader := strings.SplitN(rawHeader, ":", 2)
+		if len(splitHeader) != 2 || strings.TrimSpace(splitHeader[0]) == "" {
+			continue
+		}
+		headers.Add(strings.TrimSpace(splitHeader[0]), strings.TrimSpace(splitHeader[1]))
+	}
+	return headers
 }
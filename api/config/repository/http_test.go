@@ -1,11 +1,17 @@
 package repository
 
 import (
+	"encoding/pem"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"testing"
 
+	"github.com/monitoror/monitoror/api/config/models"
+	coreConfig "github.com/monitoror/monitoror/config"
+
 	"github.com/stretchr/testify/assert"
 )
 
@@ -19,14 +25,200 @@ func TestConfigRepository_GetConfigFromURL(t *testing.T) {
 	}))
 	defer ts.Close()
 
-	repository := NewConfigRepository()
+	repository := NewConfigRepository(nil)
 	_, err := repository.GetConfigFromURL(ts.URL)
 	assert.NoError(t, err)
 }
 
 // TestConfigRepository_GetConfigFromURL test if http get works
 func TestConfigRepository_GetConfigFromURL_Error(t *testing.T) {
-	repository := NewConfigRepository()
+	repository := NewConfigRepository(nil)
 	_, err := repository.GetConfigFromURL("http://monitoror.example.com")
 	assert.Error(t, err)
 }
+
+func TestConfigRepository_GetConfigFromURL_WithRemoteConfigs(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/bearer":
+			if r.Header.Get("Authorization") != "Bearer token" || r.Header.Get("Private-Token") != "private" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+		case "/basic":
+			if login, password, ok := r.BasicAuth(); !ok || login != "login" || password != "password" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+		}
+		_, _ = fmt.Fprintln(w, `{}`)
+	}))
+	defer ts.Close()
+
+	repository := NewConfigRepository(map[string]*coreConfig.RemoteConfig{
+		"default": {SSLVerify: true, Timeout: 1000},
+		"bearer":  {URLPrefix: ts.URL + "/bearer", Token: "token", Headers: "Private-Token: private; Invalid", Timeout: 1000},
+		"basic":   {URLPrefix: ts.URL + "/basic", Login: "login", Password: "password", Timeout: 1000},
+	})
+
+	_, err := repository.GetConfigFromURL(ts.URL + "/bearer")
+	assert.NoError(t, err)
+	_, err = repository.GetConfigFromURL(ts.URL + "/basic")
+	assert.NoError(t, err)
+	_, err = repository.GetConfigFromURL(ts.URL + "/other")
+	assert.NoError(t, err)
+
+	// Without remote config
+	repository = NewConfigRepository(nil)
+	_, err = repository.GetConfigFromURL(ts.URL + "/bearer")
+	if assert.Error(t, err) {
+		assert.Equal(t, fmt.Sprintf("Config not found at: %s/bearer, unexpected status code: 401", ts.URL), err.Error())
+	}
+}
+
+func TestConfigRepository_GetConfigFromURL_CredentialsScope(t *testing.T) {
+	var received http.Header
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+		_, _ = fmt.Fprintln(w, `{}`)
+	}))
+	defer other.Close()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/private/redirect" {
+			http.Redirect(w, r, other.URL+"/config", http.StatusFound)
+			return
+		}
+		received = r.Header.Clone()
+		_, _ = fmt.Fprintln(w, `{}`)
+	}))
+	defer ts.Close()
+
+	repository := NewConfigRepository(map[string]*coreConfig.RemoteConfig{
+		"default": {Timeout: 1000},
+		"private": {URLPrefix: ts.URL + "/private", Token: "token", Headers: "Private-Token: private", Timeout: 1000},
+	})
+
+	// Explicit prefix
+	_, err := repository.GetConfigFromURL(ts.URL + "/private/config")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Bearer token", received.Get("Authorization"))
+		assert.Equal(t, "private", received.Get("Private-Token"))
+	}
+
+	// Default remote config, without prefix, never send credentials
+	for _, url := range []string{other.URL + "/config", ts.URL + "/privateer/config", ts.URL + "/private/../config"} {
+		_, err = repository.GetConfigFromURL(url)
+		if assert.NoError(t, err) {
+			assert.Empty(t, received.Get("Authorization"), url)
+			assert.Empty(t, received.Get("Private-Token"), url)
+		}
+	}
+
+	// Redirect outside of prefix
+	_, err = repository.GetConfigFromURL(ts.URL + "/private/redirect")
+	if assert.NoError(t, err) {
+		assert.Empty(t, received.Get("Authorization"))
+		assert.Empty(t, received.Get("Private-Token"))
+	}
+}
+
+func TestConfigRepository_GetConfigFromURL_CredentialsWithoutPrefix(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintln(w, `{}`)
+	}))
+	defer ts.Close()
+
+	for _, remoteConfig := range []*coreConfig.RemoteConfig{
+		{Token: "token", Timeout: 1000},
+		{Headers: "X-Default: default", Timeout: 1000},
+		{Login: "login", Password: "password", Timeout: 1000},
+	} {
+		repository := NewConfigRepository(map[string]*coreConfig.RemoteConfig{"default": remoteConfig})
+
+		_, err := repository.GetConfigFromURL(ts.URL + "/config")
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "URL prefix is required")
+		}
+	}
+}
+
+func TestConfigRepository_GetConfigFromURL_WithTLS(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintln(w, `{}`)
+	}))
+	defer ts.Close()
+
+	// Unknown certificate
+	repository := NewConfigRepository(nil)
+	_, err := repository.GetConfigFromURL(ts.URL)
+	assert.Error(t, err)
+
+	// Skip verify
+	repository = NewConfigRepository(map[string]*coreConfig.RemoteConfig{"default": {SSLVerify: false, Timeout: 1000}})
+	_, err = repository.GetConfigFromURL(ts.URL)
+	assert.NoError(t, err)
+
+	// Custom CA bundle
+	caFile, err := ioutil.TempFile(os.TempDir(), "test-config-ca-")
+	if assert.NoError(t, err) {
+		defer os.Remove(caFile.Name())
+		_, _ = caFile.WriteString(string(pemEncode(ts.Certificate().Raw)))
+
+		repository = NewConfigRepository(map[string]*coreConfig.RemoteConfig{"default": {SSLVerify: true, CACert: caFile.Name(), Timeout: 1000}})
+		_, err = repository.GetConfigFromURL(ts.URL)
+		assert.NoError(t, err)
+	}
+
+	// Missing CA bundle
+	repository = NewConfigRepository(map[string]*coreConfig.RemoteConfig{"default": {SSLVerify: true, CACert: "/missing/ca.pem"}})
+	_, err = repository.GetConfigFromURL(ts.URL)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "unable to read CA bundle")
+	}
+}
+
+func TestConfigRepository_GetConfigFromURL_WithCache(t *testing.T) {
+	downloadCount := 0
+	down := false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if down {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		downloadCount++
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = fmt.Fprintln(w, `{"columns": 2}`)
+	}))
+	defer ts.Close()
+
+	repository := NewConfigRepository(nil)
+
+	// ETag
+	for i := 0; i < 2; i++ {
+		config, err := repository.GetConfigFromURL(ts.URL)
+		if assert.NoError(t, err) {
+			assert.Equal(t, 2, *config.Columns)
+		}
+	}
+	assert.Equal(t, 1, downloadCount)
+
+	// Last good config
+	down = true
+	config, err := repository.GetConfigFromURL(ts.URL)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 2, *config.Columns)
+	}
+
+	// No last good config
+	_, err = repository.GetConfigFromURL(ts.URL + "/other")
+	assert.IsType(t, &models.ConfigFileNotFoundError{}, err)
+}
+
+func pemEncode(der []byte) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"io"
+
+	"github.com/monitoror/monitoror/api/config/models"
+)
+
+func (cr *configRepository) GetConfigFromReader(reader io.Reader) (*models.Config, error) {
+	return ReadConfig(reader)
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/monitoror/monitoror/api/config/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigRepository_GetConfigFromReader(t *testing.T) {
+	repository := NewConfigRepository(nil)
+
+	config, err := repository.GetConfigFromReader(strings.NewReader(`{"columns": 4}`))
+	if assert.NoError(t, err) {
+		assert.Equal(t, 4, *config.Columns)
+	}
+
+	_, err = repository.GetConfigFromReader(strings.NewReader(`null`))
+	assert.Error(t, err)
+	assert.Equal(t, "null", err.(*models.ConfigUnmarshalError).RawConfig)
+}
@@ -2,23 +2,46 @@ package repository
 
 import (
 	"encoding/json"
+	"errors"
 	"io"
 	"io/ioutil"
-	"net/http"
+	"sort"
 
 	"github.com/monitoror/monitoror/api/config"
 	"github.com/monitoror/monitoror/api/config/models"
+	coreConfig "github.com/monitoror/monitoror/config"
+
+	cmap "github.com/orcaman/concurrent-map"
 )
 
 type (
 	configRepository struct {
-		httpClient *http.Client
+		// remoteClients sorted by URL prefix length, the longest first
+		remoteClients []*remoteClient
+
+		// lastGoodConfigs keep last valid config downloaded by URL. Used when remote host is down
+		lastGoodConfigs cmap.ConcurrentMap
 	}
 )
 
-func NewConfigRepository() config.Repository {
-	//TODO: Add possibility to disable SSL check?
-	return &configRepository{httpClient: http.DefaultClient}
+func NewConfigRepository(remoteConfigs map[string]*coreConfig.RemoteConfig) config.Repository {
+	cr := &configRepository{lastGoodConfigs: cmap.New()}
+
+	hasDefault := false
+	for _, remoteConfig := range remoteConfigs {
+		cr.remoteClients = append(cr.remoteClients, newRemoteClient(remoteConfig))
+		hasDefault = hasDefault || remoteConfig.URLPrefix == ""
+	}
+	// Every URL need a client
+	if !hasDefault {
+		cr.remoteClients = append(cr.remoteClients, newRemoteClient(coreConfig.DefaultRemoteConfig))
+	}
+
+	sort.SliceStable(cr.remoteClients, func(i, j int) bool {
+		return len(cr.remoteClients[i].config.URLPrefix) > len(cr.remoteClients[j].config.URLPrefix)
+	})
+
+	return cr
 }
 
 func ReadConfig(reader io.Reader) (config *models.Config, err error) {
@@ -29,6 +52,8 @@ func ReadConfig(reader io.Reader) (config *models.Config, err error) {
 
 	if err = json.Unmarshal(bytes, &config); err != nil {
 		err = &models.ConfigUnmarshalError{Err: err, RawConfig: string(bytes)}
+	} else if config == nil {
+		err = &models.ConfigUnmarshalError{Err: errors.New("empty config"), RawConfig: string(bytes)}
 	}
 
 	return
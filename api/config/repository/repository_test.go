@@ -11,7 +11,7 @@ import (
 )
 
 func TestNewConfigRepository(t *testing.T) {
-	assert.NotNil(t, NewConfigRepository())
+	assert.NotNil(t, NewConfigRepository(nil))
 }
 
 func TestRepository_ReadConfig_Success(t *testing.T) {
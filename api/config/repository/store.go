@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/monitoror/monitoror/api/config"
+	"github.com/monitoror/monitoror/api/config/models"
+)
+
+const revisionFileExtension = ".json"
+
+type (
+	// configStoreRepository store each config in its own directory, one file per revision:
+	// 		<dir>/<config name>/<revision>.json
+	configStoreRepository struct {
+		dir string
+
+		// mutex protect revision numbering
+		mutex sync.Mutex
+	}
+
+	// storedConfig is the content of a revision file
+	storedConfig struct {
+		models.ConfigRevision
+		Config *models.Config `json:"config"`
+	}
+)
+
+func NewConfigStoreRepository(dir string) config.StoreRepository {
+	return &configStoreRepository{dir: dir}
+}
+
+// GetConfigNames list configs with at least one revision
+func (sr *configStoreRepository) GetConfigNames() ([]string, error) {
+	entries, err := ioutil.ReadDir(sr.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		if revisions, _ := sr.listRevisions(entry.Name()); len(revisions) > 0 {
+			names = append(names, entry.Name())
+		}
+	}
+
+	return names, nil
+}
+
+// GetConfig return given revision of config. Revision 0 is the latest one
+func (sr *configStoreRepository) GetConfig(name string, revision int) (*models.Config, *models.ConfigRevision, error) {
+	if revision == 0 {
+		revisions, err := sr.listRevisions(name)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(revisions) == 0 {
+			return nil, nil, &models.ConfigFileNotFoundError{PathOrURL: sr.configDir(name)}
+		}
+		revision = revisions[len(revisions)-1]
+	}
+
+	stored, err := sr.readRevision(name, revision)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return stored.Config, &stored.ConfigRevision, nil
+}
+
+// GetConfigRevisions list revisions of config from the oldest to the latest
+func (sr *configStoreRepository) GetConfigRevisions(name string) ([]*models.ConfigRevision, error) {
+	revisions, err := sr.listRevisions(name)
+	if err != nil {
+		return nil, err
+	}
+	if len(revisions) == 0 {
+		return nil, &models.ConfigFileNotFoundError{PathOrURL: sr.configDir(name)}
+	}
+
+	var configRevisions []*models.ConfigRevision
+	for _, revision := range revisions {
+		stored, err := sr.readRevision(name, revision)
+		if err != nil {
+			return nil, err
+		}
+		configRevisions = append(configRevisions, &stored.ConfigRevision)
+	}
+
+	return configRevisions, nil
+}
+
+// SaveConfig write config as a new revision
+func (sr *configStoreRepository) SaveConfig(name, author string, config *models.Config) (*models.ConfigRevision, error) {
+	sr.mutex.Lock()
+	defer sr.mutex.Unlock()
+
+	revisions, err := sr.listRevisions(name)
+	if err != nil {
+		return nil, err
+	}
+
+	stored := &storedConfig{
+		ConfigRevision: models.ConfigRevision{
+			Revision:  1,
+			Author:    author,
+			CreatedAt: time.Now(),
+		},
+		Config: config,
+	}
+	if len(revisions) > 0 {
+		stored.Revision = revisions[len(revisions)-1] + 1
+	}
+
+	data, err := json.MarshalIndent(stored, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	if err = os.MkdirAll(sr.configDir(name), 0755); err != nil {
+		return nil, err
+	}
+
+	// Write into temporary file before renaming it to never expose partial revision
+	tmpFile, err := ioutil.TempFile(sr.configDir(name), ".revision-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	_, err = tmpFile.Write(data)
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err = os.Rename(tmpFile.Name(), sr.revisionPath(name, stored.Revision)); err != nil {
+		return nil, err
+	}
+
+	return &stored.ConfigRevision, nil
+}
+
+// DeleteConfig remove config and all its revisions
+func (sr *configStoreRepository) DeleteConfig(name string) error {
+	sr.mutex.Lock()
+	defer sr.mutex.Unlock()
+
+	if _, err := os.Stat(sr.configDir(name)); err != nil {
+		return &models.ConfigFileNotFoundError{Err: err, PathOrURL: sr.configDir(name)}
+	}
+
+	return os.RemoveAll(sr.configDir(name))
+}
+
+func (sr *configStoreRepository) configDir(name string) string {
+	return filepath.Join(sr.dir, name)
+}
+
+func (sr *configStoreRepository) revisionPath(name string, revision int) string {
+	return filepath.Join(sr.configDir(name), strconv.Itoa(revision)+revisionFileExtension)
+}
+
+// listRevisions return sorted revision numbers of config
+func (sr *configStoreRepository) listRevisions(name string) ([]int, error) {
+	entries, err := ioutil.ReadDir(sr.configDir(name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var revisions []int
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), revisionFileExtension) {
+			continue
+		}
+
+		if revision, err := strconv.Atoi(strings.TrimSuffix(entry.Name(), revisionFileExtension)); err == nil && revision > 0 {
+			revisions = append(revisions, revision)
+		}
+	}
+	sort.Ints(revisions)
+
+	return revisions, nil
+}
+
+func (sr *configStoreRepository) readRevision(name string, revision int) (*storedConfig, error) {
+	revisionPath := sr.revisionPath(name, revision)
+
+	data, err := ioutil.ReadFile(revisionPath)
+	if err != nil {
+		return nil, &models.ConfigFileNotFoundError{Err: err, PathOrURL: revisionPath}
+	}
+
+	stored := &storedConfig{}
+	if err = json.Unmarshal(data, stored); err != nil {
+		return nil, &models.ConfigUnmarshalError{Err: err, RawConfig: string(data)}
+	}
+
+	return stored, nil
+}
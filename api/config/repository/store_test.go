@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/monitoror/monitoror/api/config/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// /!\ this is an integration test /!\
+// Note : It may be necessary to separate them from unit tests
+
+func TestConfigStoreRepository(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "test-config-store-")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	repository := NewConfigStoreRepository(filepath.Join(dir, "store"))
+
+	// Empty store
+	names, err := repository.GetConfigNames()
+	assert.NoError(t, err)
+	assert.Len(t, names, 0)
+
+	_, _, err = repository.GetConfig("screen1", 0)
+	assert.IsType(t, &models.ConfigFileNotFoundError{}, err)
+
+	// Write 2 revisions
+	config1, _ := ReadConfig(strings.NewReader(`{"version":"2.1","columns":1,"tiles":[{"type":"EMPTY"}]}`))
+	config2, _ := ReadConfig(strings.NewReader(`{"version":"2.1","columns":2,"tiles":[{"type":"EMPTY"}]}`))
+
+	revision, err := repository.SaveConfig("screen1", "author1", config1)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 1, revision.Revision)
+		assert.Equal(t, "author1", revision.Author)
+		assert.False(t, revision.CreatedAt.IsZero())
+	}
+	revision, err = repository.SaveConfig("screen1", "author2", config2)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 2, revision.Revision)
+	}
+
+	names, err = repository.GetConfigNames()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"screen1"}, names)
+
+	// Read latest and previous revision
+	config, revision, err := repository.GetConfig("screen1", 0)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 2, *config.Columns)
+		assert.Equal(t, 2, revision.Revision)
+		assert.Equal(t, "author2", revision.Author)
+	}
+	config, revision, err = repository.GetConfig("screen1", 1)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 1, *config.Columns)
+		assert.Equal(t, 1, revision.Revision)
+	}
+	_, _, err = repository.GetConfig("screen1", 3)
+	assert.IsType(t, &models.ConfigFileNotFoundError{}, err)
+
+	revisions, err := repository.GetConfigRevisions("screen1")
+	if assert.NoError(t, err) && assert.Len(t, revisions, 2) {
+		assert.Equal(t, "author1", revisions[0].Author)
+		assert.Equal(t, "author2", revisions[1].Author)
+	}
+
+	// Delete
+	assert.NoError(t, repository.DeleteConfig("screen1"))
+	assert.IsType(t, &models.ConfigFileNotFoundError{}, repository.DeleteConfig("screen1"))
+
+	names, err = repository.GetConfigNames()
+	assert.NoError(t, err)
+	assert.Len(t, names, 0)
+
+	_, err = repository.GetConfigRevisions("screen1")
+	assert.IsType(t, &models.ConfigFileNotFoundError{}, err)
+}
+
+func TestConfigStoreRepository_UnableToParse(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "test-config-store-")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	assert.NoError(t, os.Mkdir(filepath.Join(dir, "screen1"), 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "screen1", "1.json"), []byte("xxxxxx"), 0644))
+
+	repository := NewConfigStoreRepository(dir)
+	_, _, err = repository.GetConfig("screen1", 0)
+	if assert.IsType(t, &models.ConfigUnmarshalError{}, err) {
+		assert.Equal(t, "xxxxxx", err.(*models.ConfigUnmarshalError).RawConfig)
+	}
+}
@@ -3,6 +3,8 @@
 package config
 
 import (
+	"io"
+
 	"github.com/monitoror/monitoror/api/config/models"
 )
 
@@ -10,6 +12,11 @@ type (
 	Usecase interface {
 		GetConfigList() []models.ConfigMetadata
 		GetConfig(params *models.ConfigParams) *models.ConfigBag
+		ReadConfig(reader io.Reader) *models.ConfigBag
+		GetConfigRevisions(params *models.ConfigParams) *models.ConfigBag
+		SaveConfig(params *models.ConfigParams, author string, reader io.Reader) *models.ConfigBag
+		RollbackConfig(params *models.ConfigRevisionParams, author string) *models.ConfigBag
+		DeleteConfig(params *models.ConfigParams) *models.ConfigBag
 		Verify(config *models.ConfigBag)
 		Hydrate(config *models.ConfigBag)
 	}
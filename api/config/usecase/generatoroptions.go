@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/monitoror/monitoror/api/config/models"
+	"github.com/monitoror/monitoror/pkg/humanize"
+)
+
+const (
+	// GeneratorOptionsLabelField field name used to filter / sort generated tiles on label
+	GeneratorOptionsLabelField = "label"
+)
+
+// applyGeneratorOptions filter, relabel, sort and limit generated tiles.
+// Options are verified by Verify, an error is still returned instead of panicking on invalid regex or template
+func applyGeneratorOptions(options *models.GeneratorOptions, tiles []models.TileConfig) ([]models.TileConfig, error) {
+	if options == nil {
+		return tiles, nil
+	}
+
+	include, err := compileRegexes(options.Include)
+	if err != nil {
+		return nil, fmt.Errorf(`invalid "generator.include" option: %w`, err)
+	}
+	exclude, err := compileRegexes(options.Exclude)
+	if err != nil {
+		return nil, fmt.Errorf(`invalid "generator.exclude" option: %w`, err)
+	}
+
+	var filteredTiles []models.TileConfig
+	for _, tile := range tiles {
+		fields := generatedTileFields(tile)
+		if matchAll(include, fields) && !matchAny(exclude, fields) {
+			filteredTiles = append(filteredTiles, tile)
+		}
+	}
+	tiles = filteredTiles
+
+	if options.LabelTemplate != "" {
+		labelTemplate, err := template.New(GeneratorOptionsLabelField).Option("missingkey=zero").Parse(options.LabelTemplate)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid "generator.labelTemplate" option: %w`, err)
+		}
+
+		for i := range tiles {
+			var label bytes.Buffer
+			if err := labelTemplate.Execute(&label, generatedTileFields(tiles[i])); err == nil {
+				tiles[i].Label = strings.TrimSpace(label.String())
+			}
+		}
+	}
+
+	if options.SortBy == GeneratorOptionsLabelField {
+		sort.SliceStable(tiles, func(i, j int) bool {
+			return tiles[i].Label < tiles[j].Label
+		})
+	}
+
+	if options.Limit != nil && len(tiles) > *options.Limit {
+		tiles = tiles[:*options.Limit]
+	}
+
+	return tiles, nil
+}
+
+// generatedTileFields return label and params of generated tile as string
+func generatedTileFields(tile models.TileConfig) map[string]string {
+	fields := make(map[string]string)
+	for key, value := range tile.Params {
+		if value != nil {
+			fields[key] = humanize.Interface(value)
+		}
+	}
+	fields[GeneratorOptionsLabelField] = tile.Label
+
+	return fields
+}
+
+// compileRegexes compile regex of each field
+func compileRegexes(regexes map[string]string) (map[string]*regexp.Regexp, error) {
+	compiled := make(map[string]*regexp.Regexp, len(regexes))
+	for field, regex := range regexes {
+		r, err := regexp.Compile(regex)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", field, err)
+		}
+		compiled[field] = r
+	}
+	return compiled, nil
+}
+
+func matchAll(regexes map[string]*regexp.Regexp, fields map[string]string) bool {
+	for field, regex := range regexes {
+		if !regex.MatchString(fields[field]) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchAny(regexes map[string]*regexp.Regexp, fields map[string]string) bool {
+	for field, regex := range regexes {
+		if regex.MatchString(fields[field]) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/monitoror/monitoror/api/config/models"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyGeneratorOptions(t *testing.T) {
+	tiles := []models.TileConfig{
+		{Label: "monitoror", Params: map[string]interface{}{"repository": "monitoror", "id": float64(12)}},
+		{Label: "api", Params: map[string]interface{}{"repository": "api", "id": float64(1000000)}},
+		{Label: "ui-test", Params: map[string]interface{}{"repository": "ui-test", "id": float64(3)}},
+		{Label: "backend", Params: map[string]interface{}{"repository": "backend", "id": float64(4)}},
+	}
+
+	for _, testcase := range []struct {
+		options        *models.GeneratorOptions
+		expectedLabels []string
+	}{
+		{
+			options:        nil,
+			expectedLabels: []string{"monitoror", "api", "ui-test", "backend"},
+		},
+		{
+			options:        &models.GeneratorOptions{Include: map[string]string{"label": "^[a-m]"}},
+			expectedLabels: []string{"monitoror", "api", "backend"},
+		},
+		{
+			options:        &models.GeneratorOptions{Exclude: map[string]string{"repository": "-test$", "id": "^12$"}},
+			expectedLabels: []string{"api", "backend"},
+		},
+		{
+			options:        &models.GeneratorOptions{SortBy: "label", Limit: pointer.ToInt(2)},
+			expectedLabels: []string{"api", "backend"},
+		},
+		{
+			options:        &models.GeneratorOptions{LabelTemplate: "{{.repository}} #{{.id}}{{.unknown}}", SortBy: "label"},
+			expectedLabels: []string{"api #1000000", "backend #4", "monitoror #12", "ui-test #3"},
+		},
+	} {
+		generatedTiles, err := applyGeneratorOptions(testcase.options, append([]models.TileConfig{}, tiles...))
+		assert.NoError(t, err)
+
+		var labels []string
+		for _, tile := range generatedTiles {
+			labels = append(labels, tile.Label)
+		}
+		assert.Equal(t, testcase.expectedLabels, labels)
+	}
+}
+
+func TestApplyGeneratorOptions_WithError(t *testing.T) {
+	tiles := []models.TileConfig{{Label: "monitoror"}}
+
+	for _, options := range []*models.GeneratorOptions{
+		{Include: map[string]string{"label": "("}},
+		{Exclude: map[string]string{"label": "[a-"}},
+		{LabelTemplate: "{{.label"},
+	} {
+		_, err := applyGeneratorOptions(options, tiles)
+		assert.Error(t, err)
+	}
+}
@@ -2,13 +2,14 @@ package usecase
 
 import (
 	"fmt"
+	"io"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/monitoror/monitoror/api/config/models"
 	"github.com/monitoror/monitoror/api/config/versions"
 	coreConfig "github.com/monitoror/monitoror/config"
-	"github.com/monitoror/monitoror/internal/pkg/api/config"
 	"github.com/monitoror/monitoror/internal/pkg/path"
 	"github.com/monitoror/monitoror/internal/pkg/validator/validate"
 
@@ -41,9 +42,9 @@ func init() {
 func (cu *configUsecase) GetConfigList() []models.ConfigMetadata {
 	var configList []models.ConfigMetadata
 
-	for configName := range cu.namedConfigs {
+	for _, configName := range cu.configNames() {
 		configList = append(configList, models.ConfigMetadata{
-			Name: string(configName),
+			Name: configName,
 		})
 	}
 
@@ -62,33 +63,99 @@ func (cu *configUsecase) GetConfig(params *models.ConfigParams) *models.ConfigBa
 		configName := coreConfig.ConfigName(strings.ToLower(params.Config))
 
 		// Lookup for a named Config
-		if namedConfig, ok := cu.namedConfigs[configName]; ok {
+		if namedConfig, ok := cu.getNamedConfigs()[configName]; ok {
 			if urlRegex.MatchString(namedConfig) {
 				configBag.Config, err = cu.repository.GetConfigFromURL(namedConfig)
 			} else {
 				configBag.Config, err = cu.repository.GetConfigFromPath(path.MonitororBaseDir, namedConfig)
 			}
+		} else if cu.isStoredConfig(string(configName)) {
+			// Lookup for a config written through the API
+			configBag.Config, configBag.Revision, err = cu.storeRepository.GetConfig(string(configName), 0)
 		} else {
-			message := fmt.Sprintf(`Unknown %q named config. No named configuration found.`, params.Config)
-			if len(cu.namedConfigs) != 0 {
-				message = fmt.Sprintf(`Unknown %q named config. Must be %s`, params.Config, config.Keys(cu.namedConfigs))
-			}
+			cu.addUnknownNamedConfigError(configBag, params.Config)
+		}
+	}
 
-			configBag.AddErrors(models.ConfigError{
-				ID:      models.ConfigErrorUnknownNamedConfig,
-				Message: message,
-				Data: models.ConfigErrorData{
-					Value:    params.Config,
-					Expected: config.Keys(cu.namedConfigs),
-				},
-			})
+	if err != nil {
+		addConfigLoadingError(configBag, err)
+	}
+
+	return configBag
+}
+
+// ReadConfig from raw config. Used to verify config without publishing it
+func (cu *configUsecase) ReadConfig(reader io.Reader) *models.ConfigBag {
+	configBag := &models.ConfigBag{}
+	var err error
+
+	if configBag.Config, err = cu.repository.GetConfigFromReader(reader); err != nil {
+		addConfigLoadingError(configBag, err)
+	}
+
+	return configBag
+}
+
+// getNamedConfigs merge named configs found in ConfigDir with named configs declared in CoreConfig
+func (cu *configUsecase) getNamedConfigs() map[coreConfig.ConfigName]string {
+	if cu.namedConfigDir == nil {
+		return cu.namedConfigs
+	}
+
+	namedConfigs := make(map[coreConfig.ConfigName]string)
+	for configName, namedConfig := range cu.namedConfigDir.NamedConfigs() {
+		namedConfigs[configName] = namedConfig
+	}
+	// Declared configs take precedence
+	for configName, namedConfig := range cu.namedConfigs {
+		namedConfigs[configName] = namedConfig
+	}
+
+	return namedConfigs
+}
+
+// configNames list named configs declared in CoreConfig or found in ConfigDir, followed by configs stored through the API
+func (cu *configUsecase) configNames() []string {
+	namedConfigs := cu.getNamedConfigs()
+
+	var configNames []string
+	for configName := range namedConfigs {
+		configNames = append(configNames, string(configName))
+	}
+	sort.Strings(configNames)
+
+	if cu.storeRepository != nil {
+		storedNames, _ := cu.storeRepository.GetConfigNames() // Ignoring error, stored configs are just not listed
+		for _, storedName := range storedNames {
+			if _, ok := namedConfigs[coreConfig.ConfigName(storedName)]; !ok {
+				configNames = append(configNames, storedName)
+			}
 		}
 	}
 
-	if err == nil {
-		return configBag
+	return configNames
+}
+
+func (cu *configUsecase) addUnknownNamedConfigError(configBag *models.ConfigBag, name string) {
+	configNames := strings.Join(cu.configNames(), ", ")
+
+	message := fmt.Sprintf(`Unknown %q named config. No named configuration found.`, name)
+	if configNames != "" {
+		message = fmt.Sprintf(`Unknown %q named config. Must be %s`, name, configNames)
 	}
 
+	configBag.AddErrors(models.ConfigError{
+		ID:      models.ConfigErrorUnknownNamedConfig,
+		Message: message,
+		Data: models.ConfigErrorData{
+			Value:    name,
+			Expected: configNames,
+		},
+	})
+}
+
+// addConfigLoadingError convert repository error into ConfigError
+func addConfigLoadingError(configBag *models.ConfigBag, err error) {
 	switch e := err.(type) {
 	case *models.ConfigFileNotFoundError:
 		configBag.AddErrors(models.ConfigError{
@@ -116,6 +183,20 @@ func (cu *configUsecase) GetConfig(params *models.ConfigParams) *models.ConfigBa
 				field = subMatch[0][1]
 			}
 
+			// Field replaced by a migration, suggest replacement
+			if migration, deprecation := versions.LookupDeprecation(field); deprecation != nil {
+				configBag.AddErrors(models.ConfigError{
+					ID:      models.ConfigErrorDeprecatedField,
+					Message: fmt.Sprintf(`Deprecated %q field. Replaced by %q since version %q.`, field, deprecation.Replacement, migration.Version),
+					Data: models.ConfigErrorData{
+						FieldName:     field,
+						ConfigExtract: e.RawConfig,
+						Expected:      deprecation.Replacement,
+					},
+				})
+				break
+			}
+
 			configField := structs.Fields(models.Config{})
 			tileConfigFields := structs.Fields(models.TileConfig{})
 			expectedFields := append(configField, tileConfigFields...)
@@ -189,6 +270,4 @@ func (cu *configUsecase) GetConfig(params *models.ConfigParams) *models.ConfigBa
 			Message: err.Error(),
 		})
 	}
-
-	return configBag
 }
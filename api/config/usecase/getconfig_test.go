@@ -3,12 +3,17 @@ package usecase
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/monitoror/monitoror/api/config/mocks"
 	"github.com/monitoror/monitoror/api/config/models"
 	"github.com/monitoror/monitoror/api/config/versions"
 	coreConfig "github.com/monitoror/monitoror/config"
+	"github.com/monitoror/monitoror/internal/pkg/path"
 
 	"github.com/stretchr/testify/assert"
 	. "github.com/stretchr/testify/mock"
@@ -108,7 +113,12 @@ func TestUsecase_GetConfig_WithError(t *testing.T) {
 		{
 			err:       &models.ConfigUnmarshalError{Err: errors.New(`json: unknown field "test"`), RawConfig: "test json"},
 			errorID:   models.ConfigErrorUnknownField,
-			errorData: models.ConfigErrorData{FieldName: "test", ConfigExtract: "test json", Expected: "version, columns, zoom, tiles, type, label, rowSpan, columnSpan, tiles, url, initialMaxDelay, params, configVariant"},
+			errorData: models.ConfigErrorData{FieldName: "test", ConfigExtract: "test json", Expected: "version, columns, zoom, tiles, type, label, rowSpan, columnSpan, tiles, url, initialMaxDelay, refreshInterval, cacheExpiration, aggregate, generator, thresholds, history, params, variant"},
+		},
+		{
+			err:       &models.ConfigUnmarshalError{Err: errors.New(`json: unknown field "configVariant"`), RawConfig: "test json"},
+			errorID:   models.ConfigErrorDeprecatedField,
+			errorData: models.ConfigErrorData{FieldName: "configVariant", ConfigExtract: "test json", Expected: "variant"},
 		},
 		{
 			err:       &models.ConfigUnmarshalError{Err: errors.New(`json: cannot unmarshal string into Go struct field TileConfig.tiles.test of type int`), RawConfig: "test json"},
@@ -137,3 +147,63 @@ func TestUsecase_GetConfig_WithError(t *testing.T) {
 		}
 	}
 }
+
+func TestUsecase_ReadConfig_Success(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockRepo.On("GetConfigFromReader", Anything).Return(&models.Config{}, nil)
+
+	usecase := initConfigUsecase(mockRepo)
+
+	configBag := usecase.ReadConfig(strings.NewReader(`{}`))
+	if assert.Len(t, configBag.Errors, 0) {
+		assert.NotNil(t, configBag.Config)
+		mockRepo.AssertNumberOfCalls(t, "GetConfigFromReader", 1)
+		mockRepo.AssertExpectations(t)
+	}
+}
+
+func TestUsecase_ReadConfig_WithError(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockRepo.On("GetConfigFromReader", Anything).
+		Return(nil, &models.ConfigUnmarshalError{Err: errors.New("boom"), RawConfig: "test json"})
+
+	usecase := initConfigUsecase(mockRepo)
+
+	configBag := usecase.ReadConfig(strings.NewReader(`test json`))
+	if assert.Len(t, configBag.Errors, 1) {
+		assert.Equal(t, models.ConfigErrorUnableToParseConfig, configBag.Errors[0].ID)
+		assert.Equal(t, models.ConfigErrorData{ConfigExtract: "test json"}, configBag.Errors[0].Data)
+		mockRepo.AssertNumberOfCalls(t, "GetConfigFromReader", 1)
+		mockRepo.AssertExpectations(t)
+	}
+}
+
+func TestUsecase_GetConfig_WithConfigDir(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "configDir")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(tmpDir)
+
+	_ = ioutil.WriteFile(filepath.Join(tmpDir, "default.json"), []byte(`{}`), 0644)
+	_ = ioutil.WriteFile(filepath.Join(tmpDir, "screen1.json"), []byte(`{}`), 0644)
+
+	mockRepo := new(mocks.Repository)
+	mockRepo.On("GetConfigFromPath", AnythingOfType("string"), AnythingOfType("string")).Return(&models.Config{}, nil)
+
+	usecase := initConfigUsecase(mockRepo)
+	usecase.namedConfigs = map[coreConfig.ConfigName]string{coreConfig.DefaultConfigName: "./config.json"}
+	usecase.namedConfigDir = coreConfig.NewNamedConfigDir(tmpDir)
+
+	assert.Equal(t, []models.ConfigMetadata{{Name: "default"}, {Name: "screen1"}}, usecase.GetConfigList())
+
+	configBag := usecase.GetConfig(&models.ConfigParams{Config: "screen1"})
+	assert.Len(t, configBag.Errors, 0)
+	configBag = usecase.GetConfig(&models.ConfigParams{Config: "default"})
+	assert.Len(t, configBag.Errors, 0)
+
+	// Declared config take precedence over config directory
+	mockRepo.AssertCalled(t, "GetConfigFromPath", path.MonitororBaseDir, filepath.Join(tmpDir, "screen1.json"))
+	mockRepo.AssertCalled(t, "GetConfigFromPath", path.MonitororBaseDir, "./config.json")
+	mockRepo.AssertNumberOfCalls(t, "GetConfigFromPath", 2)
+}
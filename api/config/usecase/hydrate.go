@@ -1,11 +1,14 @@
 package usecase
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"os"
 	"reflect"
+	"strconv"
+	"time"
 
 	"github.com/monitoror/monitoror/api/config/models"
 	pkgConfig "github.com/monitoror/monitoror/internal/pkg/api/config"
@@ -56,14 +59,56 @@ func (cu *configUsecase) hydrateTile(configBag *models.ConfigBag, tile *models.T
 	}
 
 	if tile.Type == GroupTileType {
+		// Sub-tiles inherit refreshInterval / cacheExpiration from group
+		for i := range tile.Tiles {
+			if tile.Tiles[i].RefreshInterval == nil {
+				tile.Tiles[i].RefreshInterval = tile.RefreshInterval
+			}
+			if tile.Tiles[i].CacheExpiration == nil {
+				tile.Tiles[i].CacheExpiration = tile.CacheExpiration
+			}
+		}
+		tile.CacheExpiration = nil
+
 		cu.hydrateTiles(configBag, &tile.Tiles)
+
+		// Aggregated group, status is computed by the core from sub-tiles URL
+		if tile.Aggregate != "" && len(tile.Tiles) > 0 {
+			checkSubTilesCount(configBag, tile, len(tile.Tiles))
+
+			urlParams := url.Values{}
+			urlParams.Set(GroupStatusAggregateQueryParam, string(tile.Aggregate))
+			for _, subTile := range tile.Tiles {
+				urlParams.Add(GroupStatusURLQueryParam, subTile.URL)
+			}
+			tile.URL = fmt.Sprintf("%s?%s", GroupStatusRoutePath, urlParams.Encode())
+			tile.InitialMaxDelay = &cu.initialMaxDelay
+			tile.Aggregate = ""
+
+			if tile.RefreshInterval != nil {
+				refreshInterval := boundDuration(*tile.RefreshInterval, cu.minTileRefreshInterval, cu.maxTileRefreshInterval)
+				tile.RefreshInterval = &refreshInterval
+			}
+		} else {
+			tile.RefreshInterval = nil
+		}
 		return
 	}
 
-	tileVariantMetadata := cu.registry.TileMetadata[tile.Type].VariantsMetadata[tile.ConfigVariant]
+	tileVariantMetadata, _ := cu.registry.GetTileVariantMetadata(tile.Type, tile.ConfigVariant)
 
 	// Change Params by a valid URL
 	urlParams := url.Values{}
+	if tile.Type == SummaryTileType {
+		// Summary tile, params are replaced by sub-tiles URL
+		subTiles := cu.hydrateSummaryTiles(configBag, tile)
+		checkSubTilesCount(configBag, tile, len(subTiles))
+		for _, subTile := range subTiles {
+			urlParams.Add(GroupStatusURLQueryParam, subTile.URL)
+		}
+		tile.Params = nil
+		tile.CacheExpiration = nil
+	}
 	for key, value := range tile.Params {
 		// Array of value
 		if reflect.TypeOf(value).Kind() == reflect.Slice {
@@ -74,16 +119,80 @@ func (cu *configUsecase) hydrateTile(configBag *models.ConfigBag, tile *models.T
 			urlParams.Add(key, humanize.Interface(value))
 		}
 	}
+
+	// Move cacheExpiration into URL, used by upstream cache middleware
+	if tile.CacheExpiration != nil {
+		cacheExpiration := boundDuration(*tile.CacheExpiration, cu.minTileCacheExpiration, cu.maxTileCacheExpiration)
+		urlParams.Set(coreModels.CacheExpirationQueryParam, strconv.Itoa(cacheExpiration))
+		tile.CacheExpiration = nil
+	}
+
+	// Move thresholds into URL, used by threshold middleware
+	if tile.Thresholds != nil {
+		if tile.Thresholds.Warning != "" {
+			urlParams.Set(coreModels.WarningThresholdQueryParam, tile.Thresholds.Warning)
+		}
+		if tile.Thresholds.Failure != "" {
+			urlParams.Set(coreModels.FailureThresholdQueryParam, tile.Thresholds.Failure)
+		}
+		tile.Thresholds = nil
+	}
+
+	// Move history into URL, used by history middleware
+	if tile.History != nil {
+		urlParams.Set(coreModels.HistorySizeQueryParam, strconv.Itoa(tile.History.Size))
+		if tile.History.Interval != nil {
+			urlParams.Set(coreModels.HistoryIntervalQueryParam, strconv.Itoa(*tile.History.Interval))
+		}
+		tile.History = nil
+	}
+
 	tile.URL = fmt.Sprintf("%s?%s", *tileVariantMetadata.RoutePath, urlParams.Encode())
 
 	// Add initial max delay from config
 	tile.InitialMaxDelay = &cu.initialMaxDelay
 
+	// Bound refresh interval with server config
+	if tile.RefreshInterval != nil {
+		refreshInterval := boundDuration(*tile.RefreshInterval, cu.minTileRefreshInterval, cu.maxTileRefreshInterval)
+		tile.RefreshInterval = &refreshInterval
+	}
+
 	// Remove Params / Variant
 	tile.Params = nil
 	tile.ConfigVariant = ""
 }
 
+// checkSubTilesCount add an error when a tile computed by the core has more sub-tiles than the core accept
+func checkSubTilesCount(configBag *models.ConfigBag, tile *models.TileConfig, count int) {
+	if count > MaxSubTilesURL {
+		configBag.AddErrors(models.ConfigError{
+			ID:      models.ConfigErrorUnableToHydrate,
+			Message: fmt.Sprintf(`Too many sub-tiles in %s tile (%d), maximum is %d.`, tile.Type, count, MaxSubTilesURL),
+			Data: models.ConfigErrorData{
+				ConfigExtract: pkgConfig.Stringify(tile),
+			},
+		})
+	}
+}
+
+// hydrateSummaryTiles hydrate sub-tiles defined in SUMMARY params. Sub-tiles inherit cacheExpiration from summary tile
+func (cu *configUsecase) hydrateSummaryTiles(configBag *models.ConfigBag, tile *models.TileConfig) []models.TileConfig {
+	summaryParams := &models.SummaryParams{}
+	bParams, _ := json.Marshal(tile.Params)
+	_ = json.Unmarshal(bParams, summaryParams)
+
+	for i := range summaryParams.Tiles {
+		if summaryParams.Tiles[i].CacheExpiration == nil {
+			summaryParams.Tiles[i].CacheExpiration = tile.CacheExpiration
+		}
+	}
+
+	cu.hydrateTiles(configBag, &summaryParams.Tiles)
+
+	return summaryParams.Tiles
+}
+
 func (cu *configUsecase) hydrateGeneratorTile(configBag *models.ConfigBag, tile *models.TileConfig) []models.TileConfig {
 	generatorMetadata := cu.registry.GeneratorMetadata[tile.Type]
 	generatorVariantMetadata := generatorMetadata.VariantsMetadata[tile.ConfigVariant]
@@ -98,7 +207,7 @@ func (cu *configUsecase) hydrateGeneratorTile(configBag *models.ConfigBag, tile
 
 	// Call builder and add inherited value from generator tile
 	cacheKey := fmt.Sprintf("%s:%s_%s_%s", TileGeneratorStoreKeyPrefix, tile.Type, tile.ConfigVariant, string(bParams))
-	results, err := generatorVariantMetadata.GeneratorFunction(rInstance)
+	results, err := cu.runGenerator(generatorVariantMetadata.GeneratorFunction, rInstance)
 	if err != nil {
 		if os.IsTimeout(err) {
 			// Get previous value in cache
@@ -134,6 +243,11 @@ func (cu *configUsecase) hydrateGeneratorTile(configBag *models.ConfigBag, tile
 			ConfigVariant: tile.ConfigVariant,
 			ColumnSpan:    tile.ColumnSpan,
 			RowSpan:       tile.RowSpan,
+
+			RefreshInterval: tile.RefreshInterval,
+			CacheExpiration: tile.CacheExpiration,
+			Thresholds:      tile.Thresholds,
+			History:         tile.History,
 		}
 
 		// Transform Tile params struct in map[string]interface{}
@@ -148,5 +262,53 @@ func (cu *configUsecase) hydrateGeneratorTile(configBag *models.ConfigBag, tile
 		tiles = append(tiles, newTile)
 	}
 
+	tiles, err = applyGeneratorOptions(tile.Generator, tiles)
+	if err != nil {
+		configBag.AddErrors(models.ConfigError{
+			ID:      models.ConfigErrorUnableToHydrate,
+			Message: fmt.Sprintf(`Error while generating %s tiles (params: %s). %v`, tile.Type, string(bParams), err),
+			Data: models.ConfigErrorData{
+				FieldName:     "generator",
+				ConfigExtract: pkgConfig.Stringify(tile),
+			},
+		})
+	}
+
 	return tiles
 }
+
+// runGenerator call generator function and stop waiting for it after generatorTimeout
+func (cu *configUsecase) runGenerator(generatorFunction models.TileGeneratorFunction, params interface{}) ([]models.GeneratedTile, error) {
+	if cu.generatorTimeout <= 0 {
+		return generatorFunction(params)
+	}
+
+	type generatorResult struct {
+		tiles []models.GeneratedTile
+		err   error
+	}
+
+	resultChan := make(chan generatorResult, 1) // Buffered to release goroutine even after timeout
+	go func() {
+		tiles, err := generatorFunction(params)
+		resultChan <- generatorResult{tiles, err}
+	}()
+
+	select {
+	case result := <-resultChan:
+		return result.tiles, result.err
+	case <-time.After(cu.generatorTimeout):
+		return nil, context.DeadlineExceeded
+	}
+}
+
+// boundDuration return duration between min and max. Bound is ignored when it's not set (<= 0)
+func boundDuration(duration, min, max int) int {
+	if min > 0 && duration < min {
+		return min
+	}
+	if max > 0 && duration > max {
+		return max
+	}
+	return duration
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/monitoror/monitoror/api/config/models"
 	"github.com/monitoror/monitoror/api/config/versions"
@@ -28,7 +29,7 @@ func TestUsecase_Hydrate(t *testing.T) {
       { "type": "PORT", "params": { "hostname": "bserver.com", "port": 22 } }
     ]},
 		{ "type": "JENKINS-BUILD", "params": { "job": "test" } },
-		{ "type": "JENKINS-BUILD", "configVariant": "variant1", "params": { "job": "test" } },
+		{ "type": "JENKINS-BUILD", "variant": "variant1", "params": { "job": "test" } },
     { "type": "PINGDOM-CHECK", "params": { "id": 10000000 } }
   ]
 }
@@ -65,6 +66,204 @@ func TestUsecase_Hydrate(t *testing.T) {
 	assert.Equal(t, 1000, *config.Config.Tiles[6].InitialMaxDelay)
 }
 
+func TestUsecase_Hydrate_WithRefreshIntervalAndCacheExpiration(t *testing.T) {
+	input := `
+{
+  "columns": 4,
+  "tiles": [
+    { "type": "PING", "refreshInterval": 30000, "cacheExpiration": 60000, "params": { "hostname": "aserver.com" } },
+    { "type": "PING", "refreshInterval": 10, "cacheExpiration": 10, "params": { "hostname": "aserver.com" } },
+    { "type": "PING", "refreshInterval": 99999999, "cacheExpiration": 99999999, "params": { "hostname": "aserver.com" } },
+    { "type": "GROUP", "label": "...", "refreshInterval": 20000, "cacheExpiration": 40000, "tiles": [
+      { "type": "PING", "params": { "hostname": "aserver.com" } },
+      { "type": "PORT", "refreshInterval": 5000, "params": { "hostname": "bserver.com", "port": 22 } }
+    ]},
+    { "type": "GENERATE:JENKINS-BUILD", "refreshInterval": 15000, "cacheExpiration": 15000, "params": {"job": "test"}}
+  ]
+}
+`
+	params := &jenkinsModels.BuildParams{Job: "test"}
+	mockBuilder := func(_ interface{}) ([]models.GeneratedTile, error) {
+		return []models.GeneratedTile{{Params: params}}, nil
+	}
+
+	usecase := initConfigUsecase(nil)
+	usecase.minTileRefreshInterval = 1000
+	usecase.maxTileRefreshInterval = 3600000
+	usecase.minTileCacheExpiration = 1000
+	usecase.maxTileCacheExpiration = 3600000
+	usecase.registry.RegisterGenerator(jenkinsApi.JenkinsBuildTileType, versions.MinimalVersion, []coreModels.VariantName{coreModels.DefaultVariantName}).
+		Enable(coreModels.DefaultVariantName, &jenkinsModels.BuildGeneratorParams{}, mockBuilder)
+
+	config, err := readConfig(input)
+	assert.NoError(t, err)
+
+	usecase.Hydrate(config)
+	assert.Len(t, config.Errors, 0)
+
+	assert.Equal(t, "/ping/default/ping?cacheExpiration=60000&hostname=aserver.com", config.Config.Tiles[0].URL)
+	assert.Equal(t, 30000, *config.Config.Tiles[0].RefreshInterval)
+	assert.Nil(t, config.Config.Tiles[0].CacheExpiration)
+	assert.Equal(t, "/ping/default/ping?cacheExpiration=1000&hostname=aserver.com", config.Config.Tiles[1].URL)
+	assert.Equal(t, 1000, *config.Config.Tiles[1].RefreshInterval)
+	assert.Equal(t, "/ping/default/ping?cacheExpiration=3600000&hostname=aserver.com", config.Config.Tiles[2].URL)
+	assert.Equal(t, 3600000, *config.Config.Tiles[2].RefreshInterval)
+
+	group := config.Config.Tiles[3]
+	assert.Nil(t, group.RefreshInterval)
+	assert.Nil(t, group.CacheExpiration)
+	assert.Equal(t, "/ping/default/ping?cacheExpiration=40000&hostname=aserver.com", group.Tiles[0].URL)
+	assert.Equal(t, 20000, *group.Tiles[0].RefreshInterval)
+	assert.Equal(t, "/port/default/port?cacheExpiration=40000&hostname=bserver.com&port=22", group.Tiles[1].URL)
+	assert.Equal(t, 5000, *group.Tiles[1].RefreshInterval)
+
+	assert.Equal(t, "/jenkins/default/build?cacheExpiration=15000&job=test", config.Config.Tiles[4].URL)
+	assert.Equal(t, 15000, *config.Config.Tiles[4].RefreshInterval)
+}
+
+func TestUsecase_Hydrate_WithAggregatedGroup(t *testing.T) {
+	input := `
+{
+  "columns": 4,
+  "tiles": [
+    { "type": "GROUP", "label": "...", "aggregate": "WORST", "refreshInterval": 20000, "tiles": [
+      { "type": "PING", "params": { "hostname": "aserver.com" } },
+      { "type": "PORT", "params": { "hostname": "bserver.com", "port": 22 } }
+    ]}
+  ]
+}
+`
+	usecase := initConfigUsecase(nil)
+
+	config, err := readConfig(input)
+	assert.NoError(t, err)
+
+	usecase.Hydrate(config)
+	assert.Len(t, config.Errors, 0)
+
+	group := config.Config.Tiles[0]
+	assert.Equal(t, "/groups/status?aggregate=WORST&url=%2Fping%2Fdefault%2Fping%3Fhostname%3Daserver.com&url=%2Fport%2Fdefault%2Fport%3Fhostname%3Dbserver.com%26port%3D22", group.URL)
+	assert.Equal(t, 1000, *group.InitialMaxDelay)
+	assert.Equal(t, 20000, *group.RefreshInterval)
+	assert.Empty(t, group.Aggregate)
+	assert.Equal(t, "/ping/default/ping?hostname=aserver.com", group.Tiles[0].URL)
+	assert.Equal(t, 20000, *group.Tiles[0].RefreshInterval)
+}
+
+func TestUsecase_Hydrate_WithSummary(t *testing.T) {
+	input := `
+{
+  "columns": 4,
+  "tiles": [
+    { "type": "SUMMARY", "label": "Production", "cacheExpiration": 30000, "params": { "tiles": [
+      { "type": "PING", "params": { "hostname": "aserver.com" } },
+      { "type": "GENERATE:JENKINS-BUILD", "params": {"job": "test"}}
+    ]}}
+  ]
+}
+`
+	params := &jenkinsModels.BuildParams{Job: "test"}
+	mockBuilder := func(_ interface{}) ([]models.GeneratedTile, error) {
+		return []models.GeneratedTile{{Params: params}}, nil
+	}
+
+	usecase := initConfigUsecase(nil)
+	usecase.registry.RegisterGenerator(jenkinsApi.JenkinsBuildTileType, versions.MinimalVersion, []coreModels.VariantName{coreModels.DefaultVariantName}).
+		Enable(coreModels.DefaultVariantName, &jenkinsModels.BuildGeneratorParams{}, mockBuilder)
+
+	config, err := readConfig(input)
+	assert.NoError(t, err)
+
+	usecase.Hydrate(config)
+	assert.Len(t, config.Errors, 0)
+
+	summary := config.Config.Tiles[0]
+	assert.Equal(t, "/summary?url=%2Fping%2Fdefault%2Fping%3FcacheExpiration%3D30000%26hostname%3Daserver.com&url=%2Fjenkins%2Fdefault%2Fbuild%3FcacheExpiration%3D30000%26job%3Dtest", summary.URL)
+	assert.Equal(t, 1000, *summary.InitialMaxDelay)
+	assert.Nil(t, summary.Params)
+	assert.Empty(t, summary.ConfigVariant)
+}
+
+func TestUsecase_Hydrate_WithSummary_TooManySubTiles(t *testing.T) {
+	input := `
+{
+  "columns": 4,
+  "tiles": [
+    { "type": "SUMMARY", "label": "Production", "params": { "tiles": [
+      { "type": "GENERATE:JENKINS-BUILD", "params": {"job": "test"}}
+    ]}}
+  ]
+}
+`
+	mockBuilder := func(_ interface{}) ([]models.GeneratedTile, error) {
+		var tiles []models.GeneratedTile
+		for i := 0; i <= MaxSubTilesURL; i++ {
+			tiles = append(tiles, models.GeneratedTile{Params: &jenkinsModels.BuildParams{Job: fmt.Sprintf("test%d", i)}})
+		}
+		return tiles, nil
+	}
+
+	usecase := initConfigUsecase(nil)
+	usecase.registry.RegisterGenerator(jenkinsApi.JenkinsBuildTileType, versions.MinimalVersion, []coreModels.VariantName{coreModels.DefaultVariantName}).
+		Enable(coreModels.DefaultVariantName, &jenkinsModels.BuildGeneratorParams{}, mockBuilder)
+
+	config, err := readConfig(input)
+	assert.NoError(t, err)
+
+	usecase.Hydrate(config)
+	if assert.Len(t, config.Errors, 1) {
+		assert.Equal(t, models.ConfigErrorUnableToHydrate, config.Errors[0].ID)
+		assert.Contains(t, config.Errors[0].Message, "Too many sub-tiles in SUMMARY tile")
+	}
+}
+
+func TestUsecase_Hydrate_WithThresholdsAndHistory(t *testing.T) {
+	input := `
+{
+  "columns": 4,
+  "tiles": [
+    { "type": "PING", "thresholds": { "warning": ">100", "failure": ">500" }, "history": { "size": 30, "interval": 60000 }, "params": { "hostname": "aserver.com" } },
+    { "type": "GENERATE:JENKINS-BUILD", "thresholds": { "failure": ">0" }, "history": { "size": 10 }, "params": {"job": "test"}}
+  ]
+}
+`
+	params := &jenkinsModels.BuildParams{Job: "test"}
+	mockBuilder := func(_ interface{}) ([]models.GeneratedTile, error) {
+		return []models.GeneratedTile{{Params: params}}, nil
+	}
+
+	usecase := initConfigUsecase(nil)
+	usecase.registry.RegisterGenerator(jenkinsApi.JenkinsBuildTileType, versions.MinimalVersion, []coreModels.VariantName{coreModels.DefaultVariantName}).
+		Enable(coreModels.DefaultVariantName, &jenkinsModels.BuildGeneratorParams{}, mockBuilder)
+
+	config, err := readConfig(input)
+	assert.NoError(t, err)
+
+	usecase.Hydrate(config)
+	assert.Len(t, config.Errors, 0)
+
+	assert.Equal(t, "/ping/default/ping?failureThreshold=%3E500&historyInterval=60000&historySize=30&hostname=aserver.com&warningThreshold=%3E100", config.Config.Tiles[0].URL)
+	assert.Nil(t, config.Config.Tiles[0].Thresholds)
+	assert.Nil(t, config.Config.Tiles[0].History)
+	assert.Equal(t, "/jenkins/default/build?failureThreshold=%3E0&historySize=10&job=test", config.Config.Tiles[1].URL)
+	assert.Nil(t, config.Config.Tiles[1].Thresholds)
+	assert.Nil(t, config.Config.Tiles[1].History)
+}
+
+func TestBoundDuration(t *testing.T) {
+	for _, testcase := range []struct {
+		duration, min, max, expected int
+	}{
+		{duration: 5000, min: 1000, max: 10000, expected: 5000},
+		{duration: 500, min: 1000, max: 10000, expected: 1000},
+		{duration: 50000, min: 1000, max: 10000, expected: 10000},
+		{duration: 500, min: 0, max: 0, expected: 500},
+		{duration: 50000, min: 1000, max: 0, expected: 50000},
+	} {
+		assert.Equal(t, testcase.expected, boundDuration(testcase.duration, testcase.min, testcase.max))
+	}
+}
+
 func TestUsecase_Hydrate_WithGenerator(t *testing.T) {
 	input := `
 {
@@ -118,6 +317,41 @@ func TestUsecase_Hydrate_WithGenerator(t *testing.T) {
 	assert.Equal(t, "Test Label", config.Config.Tiles[3].Tiles[0].Label)
 }
 
+func TestUsecase_Hydrate_WithGeneratorOptions(t *testing.T) {
+	input := `
+{
+  "columns": 4,
+  "tiles": [
+    { "type": "GENERATE:JENKINS-BUILD", "generator": { "exclude": { "branch": "^feat" }, "sortBy": "label", "labelTemplate": "{{.job}} ({{.branch}})" }, "params": {"job": "test"}}
+  ]
+}
+`
+	mockBuilder := func(_ interface{}) ([]models.GeneratedTile, error) {
+		return []models.GeneratedTile{
+			{Params: &jenkinsModels.BuildParams{Job: "test", Branch: "master"}},
+			{Params: &jenkinsModels.BuildParams{Job: "test", Branch: "feature"}},
+			{Params: &jenkinsModels.BuildParams{Job: "test", Branch: "develop"}},
+		}, nil
+	}
+
+	usecase := initConfigUsecase(nil)
+	usecase.registry.RegisterGenerator(jenkinsApi.JenkinsBuildTileType, versions.MinimalVersion, []coreModels.VariantName{coreModels.DefaultVariantName}).
+		Enable(coreModels.DefaultVariantName, &jenkinsModels.BuildGeneratorParams{}, mockBuilder)
+
+	config, err := readConfig(input)
+	assert.NoError(t, err)
+
+	usecase.Hydrate(config)
+	assert.Len(t, config.Errors, 0)
+
+	if assert.Len(t, config.Config.Tiles, 2) {
+		assert.Equal(t, "test (develop)", config.Config.Tiles[0].Label)
+		assert.Equal(t, "/jenkins/default/build?branch=develop&job=test", config.Config.Tiles[0].URL)
+		assert.Equal(t, "test (master)", config.Config.Tiles[1].Label)
+		assert.Nil(t, config.Config.Tiles[1].Generator)
+	}
+}
+
 func TestUsecase_Hydrate_WithGeneratorEmpty(t *testing.T) {
 	input := `
 {
@@ -157,7 +391,7 @@ func TestUsecase_Hydrate_WithGenerator_WithError(t *testing.T) {
 			{ "type": "GENERATE:JENKINS-BUILD", "params": {"job": "test"}}
     ]},
     { "type": "GROUP", "label": "...", "tiles": [
-    	{ "type": "GENERATE:JENKINS-BUILD", "configVariant": "variant1", "params": {"job": "test"}}
+    	{ "type": "GENERATE:JENKINS-BUILD", "variant": "variant1", "params": {"job": "test"}}
     ]}
   ]
 }
@@ -193,7 +427,7 @@ func TestUsecase_Hydrate_WithGenerator_WithTimeoutError(t *testing.T) {
 			{ "type": "GENERATE:JENKINS-BUILD", "params": {"job": "test"}}
     ]},
     { "type": "GROUP", "label": "...", "tiles": [
-    	{ "type": "GENERATE:JENKINS-BUILD", "configVariant": "variant1", "params": {"job": "test"}}
+    	{ "type": "GENERATE:JENKINS-BUILD", "variant": "variant1", "params": {"job": "test"}}
     ]}
   ]
 }
@@ -246,6 +480,40 @@ func TestUsecase_Hydrate_WithGenerator_WithTimeoutCache(t *testing.T) {
 	}
 }
 
+func TestUsecase_Hydrate_WithGenerator_WithGeneratorTimeout(t *testing.T) {
+	input := `
+{
+  "columns": 4,
+  "tiles": [
+    { "type": "GENERATE:JENKINS-BUILD", "params": {"job": "test"}}
+	]
+}
+`
+	usecase := initConfigUsecase(nil)
+	usecase.generatorTimeout = time.Millisecond
+
+	params := &jenkinsModels.BuildParams{Job: "test"}
+	cachedResult := []models.GeneratedTile{{Params: params}}
+	cacheKey := fmt.Sprintf("%s:%s_%s_%s", TileGeneratorStoreKeyPrefix, "GENERATE:JENKINS-BUILD", "default", `{"job":"test"}`)
+	_ = usecase.generatorTileStore.Add(cacheKey, cachedResult, 0)
+
+	release := make(chan struct{})
+	defer close(release)
+	mockBuilder := func(_ interface{}) ([]models.GeneratedTile, error) {
+		<-release
+		return nil, nil
+	}
+	usecase.registry.RegisterGenerator(jenkinsApi.JenkinsBuildTileType, versions.MinimalVersion, []coreModels.VariantName{coreModels.DefaultVariantName}).
+		Enable(coreModels.DefaultVariantName, &jenkinsModels.BuildGeneratorParams{}, mockBuilder)
+
+	config, err := readConfig(input)
+	if assert.NoError(t, err) {
+		usecase.Hydrate(config)
+		assert.Len(t, config.Errors, 0)
+		assert.Equal(t, "/jenkins/default/build?job=test", config.Config.Tiles[0].URL)
+	}
+}
+
 func TestUsecase_Hydrate_TwoGenerators(t *testing.T) {
 	input := `
 {
@@ -0,0 +1,176 @@
+package usecase
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/monitoror/monitoror/api/config/models"
+	coreConfig "github.com/monitoror/monitoror/config"
+)
+
+// VerifyConfigName is used by verify endpoint, it can't be used as stored config name
+const VerifyConfigName = "verify"
+
+var storedConfigNameRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// SaveConfig verify config and store it as a new revision of named config
+func (cu *configUsecase) SaveConfig(params *models.ConfigParams, author string, reader io.Reader) *models.ConfigBag {
+	configBag := &models.ConfigBag{}
+
+	configName := strings.ToLower(params.Config)
+	if !cu.checkWritableConfig(configBag, configName) {
+		return configBag
+	}
+
+	readConfigBag := cu.ReadConfig(reader)
+	if len(readConfigBag.Errors) > 0 {
+		return readConfigBag
+	}
+
+	cu.saveConfig(readConfigBag, configName, author)
+	readConfigBag.Config = nil // Only return revision, config can be fetched with GetConfig
+
+	return readConfigBag
+}
+
+// RollbackConfig store a previous revision of named config as a new revision
+func (cu *configUsecase) RollbackConfig(params *models.ConfigRevisionParams, author string) *models.ConfigBag {
+	configBag := &models.ConfigBag{}
+
+	configName := strings.ToLower(params.Config)
+	if !cu.checkWritableConfig(configBag, configName) {
+		return configBag
+	}
+	if !cu.isStoredConfig(configName) {
+		cu.addUnknownNamedConfigError(configBag, params.Config)
+		return configBag
+	}
+
+	var err error
+	if configBag.Config, _, err = cu.storeRepository.GetConfig(configName, params.Revision); err != nil {
+		if _, ok := err.(*models.ConfigFileNotFoundError); ok {
+			configBag.AddErrors(models.ConfigError{
+				ID:      models.ConfigErrorUnknownRevision,
+				Message: fmt.Sprintf(`Unknown revision %d of %q named config.`, params.Revision, params.Config),
+				Data: models.ConfigErrorData{
+					FieldName: "revision",
+					Value:     fmt.Sprint(params.Revision),
+				},
+			})
+		} else {
+			addConfigLoadingError(configBag, err)
+		}
+		return configBag
+	}
+
+	cu.saveConfig(configBag, configName, author)
+	configBag.Config = nil
+
+	return configBag
+}
+
+// DeleteConfig remove named config and its revisions from store
+func (cu *configUsecase) DeleteConfig(params *models.ConfigParams) *models.ConfigBag {
+	configBag := &models.ConfigBag{}
+
+	configName := strings.ToLower(params.Config)
+	if !cu.checkWritableConfig(configBag, configName) {
+		return configBag
+	}
+	if !cu.isStoredConfig(configName) {
+		cu.addUnknownNamedConfigError(configBag, params.Config)
+		return configBag
+	}
+
+	if err := cu.storeRepository.DeleteConfig(configName); err != nil {
+		addConfigLoadingError(configBag, err)
+	}
+
+	return configBag
+}
+
+// GetConfigRevisions list revisions of named config
+func (cu *configUsecase) GetConfigRevisions(params *models.ConfigParams) *models.ConfigBag {
+	configBag := &models.ConfigBag{}
+
+	configName := strings.ToLower(params.Config)
+	if !cu.isStoredConfig(configName) {
+		cu.addUnknownNamedConfigError(configBag, params.Config)
+		return configBag
+	}
+
+	var err error
+	if configBag.Revisions, err = cu.storeRepository.GetConfigRevisions(configName); err != nil {
+		addConfigLoadingError(configBag, err)
+	}
+
+	return configBag
+}
+
+// saveConfig run full verification before writing config as a new revision
+func (cu *configUsecase) saveConfig(configBag *models.ConfigBag, configName, author string) {
+	cu.Verify(configBag)
+	if len(configBag.Errors) > 0 {
+		return
+	}
+
+	var err error
+	if configBag.Revision, err = cu.storeRepository.SaveConfig(configName, author, configBag.Config); err != nil {
+		configBag.AddErrors(models.ConfigError{
+			ID:      models.ConfigErrorUnexpectedError,
+			Message: err.Error(),
+		})
+	}
+}
+
+// checkWritableConfig check that named config can be written through the API
+func (cu *configUsecase) checkWritableConfig(configBag *models.ConfigBag, configName string) bool {
+	if cu.storeRepository == nil {
+		configBag.AddErrors(models.ConfigError{
+			ID:      models.ConfigErrorReadOnlyNamedConfig,
+			Message: `Config store is disabled. Set "MO_CONFIGSTOREDIR" to write configs through the API.`,
+			Data:    models.ConfigErrorData{Value: configName},
+		})
+		return false
+	}
+
+	if !storedConfigNameRegex.MatchString(configName) || configName == VerifyConfigName {
+		configBag.AddErrors(models.ConfigError{
+			ID:      models.ConfigErrorInvalidNamedConfig,
+			Message: fmt.Sprintf(`Invalid %q named config. Must only contain lowercase letters, digits, "-" or "_" and can't be %q.`, configName, VerifyConfigName),
+			Data: models.ConfigErrorData{
+				Value:    configName,
+				Expected: storedConfigNameRegex.String(),
+			},
+		})
+		return false
+	}
+
+	if _, ok := cu.getNamedConfigs()[coreConfig.ConfigName(configName)]; ok {
+		configBag.AddErrors(models.ConfigError{
+			ID:      models.ConfigErrorReadOnlyNamedConfig,
+			Message: fmt.Sprintf(`Read only %q named config. It's declared by environment or config directory and can't be written through the API.`, configName),
+			Data:    models.ConfigErrorData{Value: configName},
+		})
+		return false
+	}
+
+	return true
+}
+
+func (cu *configUsecase) isStoredConfig(configName string) bool {
+	if cu.storeRepository == nil {
+		return false
+	}
+
+	storedNames, _ := cu.storeRepository.GetConfigNames()
+	for _, storedName := range storedNames {
+		if storedName == configName {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,189 @@
+package usecase
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/monitoror/monitoror/api/config/mocks"
+	"github.com/monitoror/monitoror/api/config/models"
+	"github.com/monitoror/monitoror/api/config/repository"
+	coreConfig "github.com/monitoror/monitoror/config"
+
+	"github.com/stretchr/testify/assert"
+	. "github.com/stretchr/testify/mock"
+)
+
+const storedConfig = `{"version":"2.1","columns":1,"tiles":[{"type":"EMPTY"}]}`
+
+func initStoreConfigUsecase(storeRepository *mocks.StoreRepository) *configUsecase {
+	usecase := initConfigUsecase(repository.NewConfigRepository(nil))
+	usecase.storeRepository = storeRepository
+	usecase.namedConfigs = map[coreConfig.ConfigName]string{coreConfig.DefaultConfigName: "./config.json"}
+	return usecase
+}
+
+func TestUsecase_GetConfigList_WithStoredConfigs(t *testing.T) {
+	mockStore := new(mocks.StoreRepository)
+	mockStore.On("GetConfigNames").Return([]string{"default", "screen1"}, nil)
+
+	usecase := initStoreConfigUsecase(mockStore)
+
+	assert.Equal(t, []models.ConfigMetadata{{Name: "default"}, {Name: "screen1"}}, usecase.GetConfigList())
+	mockStore.AssertExpectations(t)
+}
+
+func TestUsecase_GetConfig_WithStoredConfig(t *testing.T) {
+	config, _ := repository.ReadConfig(strings.NewReader(storedConfig))
+	revision := &models.ConfigRevision{Revision: 2, Author: "test"}
+
+	mockStore := new(mocks.StoreRepository)
+	mockStore.On("GetConfigNames").Return([]string{"screen1"}, nil)
+	mockStore.On("GetConfig", "screen1", 0).Return(config, revision, nil)
+
+	usecase := initStoreConfigUsecase(mockStore)
+
+	configBag := usecase.GetConfig(&models.ConfigParams{Config: "SCREEN1"})
+	if assert.Len(t, configBag.Errors, 0) {
+		assert.Equal(t, config, configBag.Config)
+		assert.Equal(t, revision, configBag.Revision)
+		mockStore.AssertExpectations(t)
+	}
+
+	configBag = usecase.GetConfig(&models.ConfigParams{Config: "screen2"})
+	if assert.Len(t, configBag.Errors, 1) {
+		assert.Equal(t, models.ConfigErrorUnknownNamedConfig, configBag.Errors[0].ID)
+		assert.Equal(t, "default, screen1", configBag.Errors[0].Data.Expected)
+	}
+}
+
+func TestUsecase_SaveConfig_Success(t *testing.T) {
+	revision := &models.ConfigRevision{Revision: 1, Author: "test"}
+
+	mockStore := new(mocks.StoreRepository)
+	mockStore.On("SaveConfig", "screen1", "test", AnythingOfType("*models.Config")).Return(revision, nil)
+
+	usecase := initStoreConfigUsecase(mockStore)
+
+	configBag := usecase.SaveConfig(&models.ConfigParams{Config: "Screen1"}, "test", strings.NewReader(storedConfig))
+	if assert.Len(t, configBag.Errors, 0) {
+		assert.Nil(t, configBag.Config)
+		assert.Equal(t, revision, configBag.Revision)
+		mockStore.AssertExpectations(t)
+	}
+}
+
+func TestUsecase_SaveConfig_WithError(t *testing.T) {
+	for _, testcase := range []struct {
+		name      string
+		rawConfig string
+		saveError error
+		errorID   models.ConfigErrorID
+	}{
+		{name: "default", rawConfig: storedConfig, errorID: models.ConfigErrorReadOnlyNamedConfig},
+		{name: "verify", rawConfig: storedConfig, errorID: models.ConfigErrorInvalidNamedConfig},
+		{name: "../screen1", rawConfig: storedConfig, errorID: models.ConfigErrorInvalidNamedConfig},
+		{name: "screen1", rawConfig: `{`, errorID: models.ConfigErrorUnableToParseConfig},
+		{name: "screen1", rawConfig: `{"version":"2.1","columns":1,"tiles":[{"type":"UNKNOWN"}]}`, errorID: models.ConfigErrorUnknownTileType},
+		{name: "screen1", rawConfig: storedConfig, saveError: errors.New("boom"), errorID: models.ConfigErrorUnexpectedError},
+	} {
+		mockStore := new(mocks.StoreRepository)
+		mockStore.On("SaveConfig", Anything, Anything, Anything).Return(nil, testcase.saveError)
+
+		usecase := initStoreConfigUsecase(mockStore)
+
+		configBag := usecase.SaveConfig(&models.ConfigParams{Config: testcase.name}, "test", strings.NewReader(testcase.rawConfig))
+		if assert.Len(t, configBag.Errors, 1) {
+			assert.Equal(t, testcase.errorID, configBag.Errors[0].ID)
+		}
+		if testcase.saveError == nil {
+			mockStore.AssertNotCalled(t, "SaveConfig", Anything, Anything, Anything)
+		}
+	}
+}
+
+func TestUsecase_SaveConfig_StoreDisabled(t *testing.T) {
+	usecase := initConfigUsecase(repository.NewConfigRepository(nil))
+
+	configBag := usecase.SaveConfig(&models.ConfigParams{Config: "screen1"}, "test", strings.NewReader(storedConfig))
+	if assert.Len(t, configBag.Errors, 1) {
+		assert.Equal(t, models.ConfigErrorReadOnlyNamedConfig, configBag.Errors[0].ID)
+	}
+}
+
+func TestUsecase_RollbackConfig(t *testing.T) {
+	config, _ := repository.ReadConfig(strings.NewReader(storedConfig))
+	revision := &models.ConfigRevision{Revision: 3, Author: "test"}
+
+	mockStore := new(mocks.StoreRepository)
+	mockStore.On("GetConfigNames").Return([]string{"screen1"}, nil)
+	mockStore.On("GetConfig", "screen1", 1).Return(config, &models.ConfigRevision{Revision: 1}, nil)
+	mockStore.On("GetConfig", "screen1", 5).Return(nil, nil, &models.ConfigFileNotFoundError{PathOrURL: "5.json"})
+	mockStore.On("SaveConfig", "screen1", "test", config).Return(revision, nil)
+
+	usecase := initStoreConfigUsecase(mockStore)
+
+	configBag := usecase.RollbackConfig(&models.ConfigRevisionParams{Config: "screen1", Revision: 1}, "test")
+	if assert.Len(t, configBag.Errors, 0) {
+		assert.Equal(t, revision, configBag.Revision)
+	}
+
+	configBag = usecase.RollbackConfig(&models.ConfigRevisionParams{Config: "screen1", Revision: 5}, "test")
+	if assert.Len(t, configBag.Errors, 1) {
+		assert.Equal(t, models.ConfigErrorUnknownRevision, configBag.Errors[0].ID)
+	}
+
+	configBag = usecase.RollbackConfig(&models.ConfigRevisionParams{Config: "screen2", Revision: 1}, "test")
+	if assert.Len(t, configBag.Errors, 1) {
+		assert.Equal(t, models.ConfigErrorUnknownNamedConfig, configBag.Errors[0].ID)
+	}
+
+	mockStore.AssertNumberOfCalls(t, "SaveConfig", 1)
+	mockStore.AssertExpectations(t)
+}
+
+func TestUsecase_DeleteConfig(t *testing.T) {
+	mockStore := new(mocks.StoreRepository)
+	mockStore.On("GetConfigNames").Return([]string{"screen1"}, nil)
+	mockStore.On("DeleteConfig", "screen1").Return(nil)
+
+	usecase := initStoreConfigUsecase(mockStore)
+
+	configBag := usecase.DeleteConfig(&models.ConfigParams{Config: "screen1"})
+	assert.Len(t, configBag.Errors, 0)
+
+	configBag = usecase.DeleteConfig(&models.ConfigParams{Config: "screen2"})
+	if assert.Len(t, configBag.Errors, 1) {
+		assert.Equal(t, models.ConfigErrorUnknownNamedConfig, configBag.Errors[0].ID)
+	}
+
+	configBag = usecase.DeleteConfig(&models.ConfigParams{Config: "default"})
+	if assert.Len(t, configBag.Errors, 1) {
+		assert.Equal(t, models.ConfigErrorReadOnlyNamedConfig, configBag.Errors[0].ID)
+	}
+
+	mockStore.AssertNumberOfCalls(t, "DeleteConfig", 1)
+	mockStore.AssertExpectations(t)
+}
+
+func TestUsecase_GetConfigRevisions(t *testing.T) {
+	revisions := []*models.ConfigRevision{{Revision: 1, Author: "test"}, {Revision: 2, Author: "test"}}
+
+	mockStore := new(mocks.StoreRepository)
+	mockStore.On("GetConfigNames").Return([]string{"screen1"}, nil)
+	mockStore.On("GetConfigRevisions", "screen1").Return(revisions, nil)
+
+	usecase := initStoreConfigUsecase(mockStore)
+
+	configBag := usecase.GetConfigRevisions(&models.ConfigParams{Config: "screen1"})
+	if assert.Len(t, configBag.Errors, 0) {
+		assert.Equal(t, revisions, configBag.Revisions)
+	}
+
+	configBag = usecase.GetConfigRevisions(&models.ConfigParams{Config: "default"})
+	if assert.Len(t, configBag.Errors, 1) {
+		assert.Equal(t, models.ConfigErrorUnknownNamedConfig, configBag.Errors[0].ID)
+	}
+
+	mockStore.AssertExpectations(t)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/monitoror/monitoror/api/config"
 	"github.com/monitoror/monitoror/api/config/models"
 	coreConfig "github.com/monitoror/monitoror/config"
+	"github.com/monitoror/monitoror/internal/pkg/path"
 	coreModels "github.com/monitoror/monitoror/models"
 	"github.com/monitoror/monitoror/registry"
 	"github.com/monitoror/monitoror/store"
@@ -14,8 +15,20 @@ import (
 )
 
 const (
-	EmptyTileType coreModels.TileType = "EMPTY"
-	GroupTileType coreModels.TileType = "GROUP"
+	EmptyTileType   coreModels.TileType = "EMPTY"
+	GroupTileType   coreModels.TileType = "GROUP"
+	SummaryTileType coreModels.TileType = "SUMMARY"
+
+	// GroupStatusRoutePath path of the api endpoint computing status of aggregated group tiles
+	GroupStatusRoutePath = "/groups/status"
+	// GroupStatusAggregateQueryParam / GroupStatusURLQueryParam query params of GroupStatusRoutePath
+	GroupStatusAggregateQueryParam = "aggregate"
+	GroupStatusURLQueryParam       = "url"
+
+	// SummaryRoutePath path of the api endpoint computing status of SUMMARY tiles. Use GroupStatusURLQueryParam for sub-tiles URL
+	SummaryRoutePath = "/summary"
+	// MaxSubTilesURL maximum number of GroupStatusURLQueryParam accepted by GroupStatusRoutePath and SummaryRoutePath
+	MaxSubTilesURL = 100
 
 	TileGeneratorStoreKeyPrefix = "monitoror.config.tileGenerator.key"
 )
@@ -23,33 +36,57 @@ const (
 type (
 	configUsecase struct {
 		repository config.Repository
+		// storeRepository keep configs written through the API. nil when config store is disabled
+		storeRepository config.StoreRepository
 
 		registry *registry.MetadataRegistry
 
 		// namedConfigs used in GetConfig
 		namedConfigs map[coreConfig.ConfigName]string
+		// namedConfigDir discover more named configs in ConfigDir. nil when ConfigDir is not set
+		namedConfigDir *coreConfig.NamedConfigDir
 
 		// generator tile cache. used in case of timeout
 		generatorTileStore cache.Store
 		cacheExpiration    time.Duration
+		generatorTimeout   time.Duration
 
 		initialMaxDelay int
+
+		// Bounds of refreshInterval / cacheExpiration set on tiles
+		minTileRefreshInterval int
+		maxTileRefreshInterval int
+		minTileCacheExpiration int
+		maxTileCacheExpiration int
 	}
 )
 
-func NewConfigUsecase(repository config.Repository, store *store.Store) config.Usecase {
+func NewConfigUsecase(repository config.Repository, storeRepository config.StoreRepository, store *store.Store) config.Usecase {
 	tileConfigs := make(map[coreModels.TileType]map[string]*models.TileConfig)
 
 	// Used for authorized type
 	tileConfigs[EmptyTileType] = nil
 	tileConfigs[GroupTileType] = nil
 
+	var namedConfigDir *coreConfig.NamedConfigDir
+	if store.CoreConfig.ConfigDir != "" {
+		namedConfigDir = coreConfig.NewNamedConfigDir(path.ToAbsolute(path.MonitororBaseDir, store.CoreConfig.ConfigDir))
+	}
+
 	return &configUsecase{
 		repository:         repository,
+		storeRepository:    storeRepository,
 		registry:           store.Registry.(*registry.MetadataRegistry),
 		namedConfigs:       store.CoreConfig.NamedConfigs,
+		namedConfigDir:     namedConfigDir,
 		generatorTileStore: store.CacheStore,
 		cacheExpiration:    time.Millisecond * time.Duration(store.CoreConfig.DownstreamCacheExpiration),
+		generatorTimeout:   time.Millisecond * time.Duration(store.CoreConfig.GeneratorTimeout),
 		initialMaxDelay:    store.CoreConfig.InitialMaxDelay,
+
+		minTileRefreshInterval: store.CoreConfig.MinTileRefreshInterval,
+		maxTileRefreshInterval: store.CoreConfig.MaxTileRefreshInterval,
+		minTileCacheExpiration: store.CoreConfig.MinTileCacheExpiration,
+		maxTileCacheExpiration: store.CoreConfig.MaxTileCacheExpiration,
 	}
 }
@@ -36,7 +36,7 @@ func initConfigUsecase(repository config.Repository) *configUsecase {
 		Registry:   registry.NewRegistry(),
 	}
 
-	usecase := NewConfigUsecase(repository, s).(*configUsecase)
+	usecase := NewConfigUsecase(repository, nil, s).(*configUsecase)
 
 	usecase.registry.RegisterTile(pingApi.PingTileType, versions.MinimalVersion, []coreModels.VariantName{coreModels.DefaultVariantName}).
 		Enable(coreModels.DefaultVariantName, &pingModels.PingParams{}, "/ping/default/ping")
@@ -46,6 +46,8 @@ func initConfigUsecase(repository config.Repository) *configUsecase {
 		Enable(coreModels.DefaultVariantName, &jenkinsModels.BuildParams{}, "/jenkins/default/build")
 	usecase.registry.RegisterTile(pingdomApi.PingdomCheckTileType, versions.MinimalVersion, []coreModels.VariantName{coreModels.DefaultVariantName}).
 		Enable(coreModels.DefaultVariantName, &pindomModels.CheckParams{}, "/pingdom/default/check")
+	usecase.registry.RegisterTile(SummaryTileType, versions.MinimalVersion, []coreModels.VariantName{coreModels.DefaultVariantName}).
+		Enable(coreModels.DefaultVariantName, &models.SummaryParams{}, SummaryRoutePath)
 
 	return usecase
 }
@@ -83,3 +85,30 @@ func TestUsecase_Global_Success(t *testing.T) {
 		assert.Equal(t, expectRawConfig, string(marshal))
 	}
 }
+
+func TestUsecase_Global_MigratedConfig(t *testing.T) {
+	rawConfig := fmt.Sprintf(`
+{
+	"version" : %q,
+  "columns": 4,
+  "tiles": [
+		{ "type": "PORT", "label": "Monitoror", "configVariant": "default", "params": {"hostname": "localhost", "port": 8080} }
+  ]
+}
+`, versions.Version2000)
+
+	expectRawConfig := fmt.Sprintf(`{"config":{"version":%q,"columns":4,"tiles":[{"type":"PORT","label":"Monitoror","url":"/port/default/port?hostname=localhost\u0026port=8080","initialMaxDelay":1000}]}}`, versions.CurrentVersion)
+
+	config, err := readConfig(rawConfig)
+	if assert.NoError(t, err) {
+		usecase := initConfigUsecase(nil)
+		usecase.Verify(config)
+		usecase.Hydrate(config)
+
+		assert.Len(t, config.Errors, 0)
+
+		marshal, err := json.Marshal(config)
+		assert.NoError(t, err)
+		assert.Equal(t, expectRawConfig, string(marshal))
+	}
+}
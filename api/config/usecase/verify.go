@@ -4,7 +4,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"regexp"
 	"strings"
+	"text/template"
 
 	"github.com/fatih/structs"
 
@@ -17,6 +19,7 @@ import (
 	"github.com/monitoror/monitoror/internal/pkg/validator/validate"
 	coreModels "github.com/monitoror/monitoror/models"
 	pkgStructs "github.com/monitoror/monitoror/pkg/structs"
+	"github.com/monitoror/monitoror/pkg/threshold"
 	"github.com/monitoror/monitoror/registry"
 )
 
@@ -74,6 +77,81 @@ func (cu *configUsecase) verifyTile(configBag *models.ConfigBag, tile *models.Ti
 		return
 	}
 
+	// Aggregate is only allowed on group tile
+	if tile.Type != GroupTileType && tile.Aggregate != "" {
+		configBag.AddErrors(models.ConfigError{
+			ID:      models.ConfigErrorUnauthorizedField,
+			Message: fmt.Sprintf(`Unauthorized "aggregate" key in %s tile definition. Only allowed in %s tile.`, tile.Type, GroupTileType),
+			Data: models.ConfigErrorData{
+				FieldName:     "aggregate",
+				ConfigExtract: pkgConfig.Stringify(tile),
+			},
+		})
+		return
+	}
+
+	// Generator options are only allowed on generator tile
+	if tile.Generator != nil {
+		if !tile.Type.IsGenerator() {
+			configBag.AddErrors(models.ConfigError{
+				ID:      models.ConfigErrorUnauthorizedField,
+				Message: fmt.Sprintf(`Unauthorized "generator" key in %s tile definition. Only allowed in generator tile.`, tile.Type),
+				Data: models.ConfigErrorData{
+					FieldName:     "generator",
+					ConfigExtract: pkgConfig.Stringify(tile),
+				},
+			})
+			return
+		}
+
+		if !verifyGeneratorOptions(configBag, tile) {
+			return
+		}
+	}
+
+	// Thresholds are only allowed on tile with value
+	if tile.Thresholds != nil {
+		if tile.Type == EmptyTileType || tile.Type == GroupTileType {
+			configBag.AddErrors(models.ConfigError{
+				ID:      models.ConfigErrorUnauthorizedField,
+				Message: fmt.Sprintf(`Unauthorized "thresholds" key in %s tile definition.`, tile.Type),
+				Data: models.ConfigErrorData{
+					FieldName:     "thresholds",
+					ConfigExtract: pkgConfig.Stringify(tile),
+				},
+			})
+			return
+		}
+
+		if !verifyThresholds(configBag, tile) {
+			return
+		}
+	}
+
+	// History is only allowed on tile with value
+	if tile.History != nil {
+		if tile.Type == EmptyTileType || tile.Type == GroupTileType {
+			configBag.AddErrors(models.ConfigError{
+				ID:      models.ConfigErrorUnauthorizedField,
+				Message: fmt.Sprintf(`Unauthorized "history" key in %s tile definition.`, tile.Type),
+				Data: models.ConfigErrorData{
+					FieldName:     "history",
+					ConfigExtract: pkgConfig.Stringify(tile),
+				},
+			})
+			return
+		}
+
+		if errors := validate.Struct(tile.History); len(errors) > 0 {
+			for _, vError := range errors {
+				configError := convertValidatorError(vError, tile.History, pkgConfig.Stringify(tile))
+				configError.Data.FieldName = fmt.Sprintf("history.%s", configError.Data.FieldName)
+				configBag.AddErrors(*configError)
+			}
+			return
+		}
+	}
+
 	// Empty tile, skip
 	if tile.Type == EmptyTileType {
 		if groupTile != nil {
@@ -89,6 +167,19 @@ func (cu *configUsecase) verifyTile(configBag *models.ConfigBag, tile *models.Ti
 		return
 	}
 
+	// Summary tile status is computed by the core from its sub-tiles URL, it can't be aggregated again
+	if tile.Type == SummaryTileType && groupTile != nil && groupTile.Aggregate != "" {
+		configBag.AddErrors(models.ConfigError{
+			ID:      models.ConfigErrorUnauthorizedSubtileType,
+			Message: fmt.Sprintf(`Unauthorized %q type in %s tile with "aggregate" key.`, SummaryTileType, GroupTileType),
+			Data: models.ConfigErrorData{
+				ConfigExtract:          pkgConfig.Stringify(groupTile),
+				ConfigExtractHighlight: pkgConfig.Stringify(tile),
+			},
+		})
+		return
+	}
+
 	// Group tile, parse and call verifyTile for each grouped tile
 	if tile.Type == GroupTileType {
 		if groupTile != nil {
@@ -168,15 +259,16 @@ func (cu *configUsecase) verifyTile(configBag *models.ConfigBag, tile *models.Ti
 		}
 	} else {
 		// This tile type is a normal tile type
-		metadataExplorer, exists = cu.registry.TileMetadata[tile.Type]
+		tileMetadata := cu.registry.GetTileMetadata()
+		metadataExplorer, exists = tileMetadata[tile.Type]
 		if !exists {
 			configBag.AddErrors(models.ConfigError{
 				ID:      models.ConfigErrorUnknownTileType,
-				Message: fmt.Sprintf(`Unknown %q generator type in tile definition. Must be %s`, tile.Type, pkgConfig.Keys(cu.registry.TileMetadata)),
+				Message: fmt.Sprintf(`Unknown %q generator type in tile definition. Must be %s`, tile.Type, pkgConfig.Keys(tileMetadata)),
 				Data: models.ConfigErrorData{
 					FieldName:     "type",
 					ConfigExtract: pkgConfig.Stringify(tile),
-					Expected:      pkgConfig.Keys(cu.registry.TileMetadata),
+					Expected:      pkgConfig.Keys(tileMetadata),
 				},
 			})
 			return
@@ -206,7 +298,7 @@ func (cu *configUsecase) verifyTile(configBag *models.ConfigBag, tile *models.Ti
 			Message: fmt.Sprintf(`Unknown %q variant for %s type in tile definition. Must be %s`,
 				tile.ConfigVariant, tile.Type, pkgConfig.Stringify(metadataExplorer.GetVariantsNames())),
 			Data: models.ConfigErrorData{
-				FieldName:     "configVariant",
+				FieldName:     "variant",
 				Value:         pkgConfig.Stringify(tile.ConfigVariant),
 				Expected:      pkgConfig.Stringify(metadataExplorer.GetVariantsNames()),
 				ConfigExtract: pkgConfig.Stringify(tile),
@@ -221,7 +313,7 @@ func (cu *configUsecase) verifyTile(configBag *models.ConfigBag, tile *models.Ti
 			ID:      models.ConfigErrorDisabledVariant,
 			Message: fmt.Sprintf(`Variant %q is disabled for %s type. Check errors on the server side for the reason`, tile.ConfigVariant, tile.Type),
 			Data: models.ConfigErrorData{
-				FieldName:     "configVariant",
+				FieldName:     "variant",
 				Value:         pkgConfig.Stringify(tile.ConfigVariant),
 				ConfigExtract: pkgConfig.Stringify(tile),
 			},
@@ -242,9 +334,14 @@ func (cu *configUsecase) verifyTile(configBag *models.ConfigBag, tile *models.Ti
 		return
 	}
 
-	// Create new validator by reflexion
-	rType := reflect.TypeOf(variantMetadataExplorer.GetValidator())
-	rInstance := reflect.New(rType.Elem()).Interface()
+	// Create new validator by factory or by reflexion
+	var rInstance interface{}
+	if factory, ok := variantMetadataExplorer.GetValidator().(params.Factory); ok {
+		rInstance = factory.NewValidator()
+	} else {
+		rType := reflect.TypeOf(variantMetadataExplorer.GetValidator())
+		rInstance = reflect.New(rType.Elem()).Interface()
+	}
 
 	// Marshal / Unmarshal the map[string]interface{} struct in new instance of ParamsValidator
 	bytesParams, _ := json.Marshal(tile.Params)
@@ -303,6 +400,105 @@ func (cu *configUsecase) verifyTile(configBag *models.ConfigBag, tile *models.Ti
 
 		configBag.AddErrors(*configError)
 	}
+
+	// Summary tile, verify each sub-tile defined in params
+	if summaryParams, ok := castedParams.(*models.SummaryParams); ok && len(errors) == 0 {
+		cu.verifySummaryTiles(configBag, tile, summaryParams)
+	}
+}
+
+func (cu *configUsecase) verifySummaryTiles(configBag *models.ConfigBag, tile *models.TileConfig, summaryParams *models.SummaryParams) {
+	for _, subTile := range summaryParams.Tiles {
+		if subTile.Type == EmptyTileType || subTile.Type == GroupTileType || subTile.Type == SummaryTileType {
+			configBag.AddErrors(models.ConfigError{
+				ID:      models.ConfigErrorUnauthorizedSubtileType,
+				Message: fmt.Sprintf(`Unauthorized %q type in %s tile.`, subTile.Type, SummaryTileType),
+				Data: models.ConfigErrorData{
+					ConfigExtract:          pkgConfig.Stringify(tile),
+					ConfigExtractHighlight: pkgConfig.Stringify(subTile),
+				},
+			})
+			continue
+		}
+
+		cu.verifyTile(configBag, &subTile, tile)
+	}
+}
+
+// verifyGeneratorOptions validate generator options of tile. Return false in case of error
+func verifyGeneratorOptions(configBag *models.ConfigBag, tile *models.TileConfig) bool {
+	errorCount := len(configBag.Errors)
+
+	for _, vError := range validate.Struct(tile.Generator) {
+		configError := convertValidatorError(vError, tile.Generator, pkgConfig.Stringify(tile))
+		configError.Data.FieldName = fmt.Sprintf("generator.%s", configError.Data.FieldName)
+		configBag.AddErrors(*configError)
+	}
+
+	for _, option := range []struct {
+		fieldName string
+		regexes   map[string]string
+	}{{"include", tile.Generator.Include}, {"exclude", tile.Generator.Exclude}} {
+		fieldName := option.fieldName
+		for field, regex := range option.regexes {
+			if _, err := regexp.Compile(regex); err != nil {
+				configBag.AddErrors(models.ConfigError{
+					ID:      models.ConfigErrorInvalidFieldValue,
+					Message: fmt.Sprintf(`Invalid regex %q for %q field in "generator.%s". %v`, regex, field, fieldName, err),
+					Data: models.ConfigErrorData{
+						FieldName:     fmt.Sprintf("generator.%s", fieldName),
+						Value:         pkgConfig.Stringify(regex),
+						ConfigExtract: pkgConfig.Stringify(tile),
+					},
+				})
+			}
+		}
+	}
+
+	if tile.Generator.LabelTemplate != "" {
+		if _, err := template.New("label").Parse(tile.Generator.LabelTemplate); err != nil {
+			configBag.AddErrors(models.ConfigError{
+				ID:      models.ConfigErrorInvalidFieldValue,
+				Message: fmt.Sprintf(`Invalid "generator.labelTemplate" field. %v`, err),
+				Data: models.ConfigErrorData{
+					FieldName:     "generator.labelTemplate",
+					Value:         pkgConfig.Stringify(tile.Generator.LabelTemplate),
+					ConfigExtract: pkgConfig.Stringify(tile),
+				},
+			})
+		}
+	}
+
+	return len(configBag.Errors) == errorCount
+}
+
+// verifyThresholds parse thresholds of tile. Return false in case of error
+func verifyThresholds(configBag *models.ConfigBag, tile *models.TileConfig) bool {
+	errorCount := len(configBag.Errors)
+
+	for _, option := range []struct {
+		fieldName  string
+		expression string
+	}{{"warning", tile.Thresholds.Warning}, {"failure", tile.Thresholds.Failure}} {
+		if option.expression == "" {
+			continue
+		}
+
+		if _, err := threshold.Parse(option.expression); err != nil {
+			configBag.AddErrors(models.ConfigError{
+				ID:      models.ConfigErrorInvalidFieldValue,
+				Message: fmt.Sprintf(`Invalid "thresholds.%s" field. %v`, option.fieldName, err),
+				Data: models.ConfigErrorData{
+					FieldName:     fmt.Sprintf("thresholds.%s", option.fieldName),
+					Value:         pkgConfig.Stringify(option.expression),
+					Expected:      ">, >=, <, <=, ==, != followed by a number",
+					ConfigExtract: pkgConfig.Stringify(tile),
+				},
+			})
+		}
+	}
+
+	return len(configBag.Errors) == errorCount
 }
 
 // validateStruct Validate struct with "validate" and "available" tag
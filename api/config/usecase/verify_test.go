@@ -11,6 +11,7 @@ import (
 	coreModels "github.com/monitoror/monitoror/models"
 	jenkinsApi "github.com/monitoror/monitoror/monitorables/jenkins/api"
 	jenkinsModels "github.com/monitoror/monitoror/monitorables/jenkins/api/models"
+	pluginModels "github.com/monitoror/monitoror/monitorables/plugin/api/models"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -197,6 +198,108 @@ func TestUsecase_VerifyTile_Success_Group(t *testing.T) {
 	assert.Len(t, conf.Errors, 0)
 }
 
+func TestUsecase_VerifyTile_Success_AggregatedGroup(t *testing.T) {
+	rawConfig := `
+      { "type": "GROUP", "label": "...", "aggregate": "MAJORITY", "tiles": [
+          { "type": "PING", "params": { "hostname": "aserver.com" } },
+          { "type": "PORT", "params": { "hostname": "bserver.com", "port": 22 } }
+			]}
+`
+
+	tile, conf := initConfig(t, rawConfig)
+	usecase := initConfigUsecase(nil)
+	usecase.verifyTile(conf, tile, nil)
+
+	assert.Len(t, conf.Errors, 0)
+}
+
+func TestUsecase_VerifyTile_Success_Summary(t *testing.T) {
+	rawConfig := `
+      { "type": "SUMMARY", "label": "Production", "params": { "tiles": [
+          { "type": "PING", "params": { "hostname": "aserver.com" } },
+          { "type": "PORT", "params": { "hostname": "bserver.com", "port": 22 } }
+			]}}
+`
+
+	tile, conf := initConfig(t, rawConfig)
+	usecase := initConfigUsecase(nil)
+	usecase.verifyTile(conf, tile, nil)
+
+	assert.Len(t, conf.Errors, 0)
+}
+
+func TestUsecase_VerifyTile_Failed_Summary(t *testing.T) {
+	for _, testcase := range []struct {
+		rawConfig string
+		errorID   models.ConfigErrorID
+		fieldName string
+	}{
+		{rawConfig: `{ "type": "SUMMARY", "params": { "tiles": [] } }`, errorID: models.ConfigErrorInvalidFieldValue, fieldName: "tiles"},
+		{rawConfig: `{ "type": "SUMMARY", "params": { "tiles": [{ "type": "GROUP", "tiles": [{ "type": "PING", "params": { "hostname": "aserver.com" } }] }] } }`, errorID: models.ConfigErrorUnauthorizedSubtileType},
+		{rawConfig: `{ "type": "SUMMARY", "params": { "tiles": [{ "type": "SUMMARY", "params": { "tiles": [] } }] } }`, errorID: models.ConfigErrorUnauthorizedSubtileType},
+		{rawConfig: `{ "type": "SUMMARY", "params": { "tiles": [{ "type": "PING", "params": {} }] } }`, errorID: models.ConfigErrorMissingRequiredField, fieldName: "hostname"},
+	} {
+		tile, conf := initConfig(t, testcase.rawConfig)
+		usecase := initConfigUsecase(nil)
+		usecase.verifyTile(conf, tile, nil)
+
+		if assert.Len(t, conf.Errors, 1, testcase.rawConfig) {
+			assert.Equal(t, testcase.errorID, conf.Errors[0].ID)
+			assert.Equal(t, testcase.fieldName, conf.Errors[0].Data.FieldName)
+		}
+	}
+}
+
+func TestUsecase_VerifyTile_Failed_GeneratorOptions(t *testing.T) {
+	for _, testcase := range []struct {
+		rawConfig string
+		errorID   models.ConfigErrorID
+		fieldName string
+	}{
+		{rawConfig: `{ "type": "PING", "generator": { "limit": 2 }, "params": { "hostname": "server.com" } }`, errorID: models.ConfigErrorUnauthorizedField, fieldName: "generator"},
+		{rawConfig: `{ "type": "GENERATE:JENKINS-BUILD", "generator": { "limit": 0 }, "params": { "job": "test" } }`, errorID: models.ConfigErrorInvalidFieldValue, fieldName: "generator.limit"},
+		{rawConfig: `{ "type": "GENERATE:JENKINS-BUILD", "generator": { "sortBy": "id" }, "params": { "job": "test" } }`, errorID: models.ConfigErrorInvalidFieldValue, fieldName: "generator.sortBy"},
+		{rawConfig: `{ "type": "GENERATE:JENKINS-BUILD", "generator": { "include": { "label": "(" } }, "params": { "job": "test" } }`, errorID: models.ConfigErrorInvalidFieldValue, fieldName: "generator.include"},
+		{rawConfig: `{ "type": "GENERATE:JENKINS-BUILD", "generator": { "exclude": { "job": "[" } }, "params": { "job": "test" } }`, errorID: models.ConfigErrorInvalidFieldValue, fieldName: "generator.exclude"},
+		{rawConfig: `{ "type": "GENERATE:JENKINS-BUILD", "generator": { "labelTemplate": "{{.job" }, "params": { "job": "test" } }`, errorID: models.ConfigErrorInvalidFieldValue, fieldName: "generator.labelTemplate"},
+	} {
+		tile, conf := initConfig(t, testcase.rawConfig)
+		usecase := initConfigUsecase(nil)
+		usecase.registry.RegisterGenerator(jenkinsApi.JenkinsBuildTileType, versions.MinimalVersion, []coreModels.VariantName{coreModels.DefaultVariantName}).
+			Enable(coreModels.DefaultVariantName, &jenkinsModels.BuildGeneratorParams{}, nil)
+		usecase.verifyTile(conf, tile, nil)
+
+		if assert.Len(t, conf.Errors, 1, testcase.rawConfig) {
+			assert.Equal(t, testcase.errorID, conf.Errors[0].ID)
+			assert.Equal(t, testcase.fieldName, conf.Errors[0].Data.FieldName)
+		}
+	}
+}
+
+func TestUsecase_VerifyTile_Failed_ThresholdsAndHistory(t *testing.T) {
+	for _, testcase := range []struct {
+		rawConfig string
+		errorID   models.ConfigErrorID
+		fieldName string
+	}{
+		{rawConfig: `{ "type": "EMPTY", "thresholds": { "failure": ">0" } }`, errorID: models.ConfigErrorUnauthorizedField, fieldName: "thresholds"},
+		{rawConfig: `{ "type": "PING", "thresholds": { "warning": "10" }, "params": { "hostname": "server.com" } }`, errorID: models.ConfigErrorInvalidFieldValue, fieldName: "thresholds.warning"},
+		{rawConfig: `{ "type": "PING", "thresholds": { "warning": ">10", "failure": ">>25" }, "params": { "hostname": "server.com" } }`, errorID: models.ConfigErrorInvalidFieldValue, fieldName: "thresholds.failure"},
+		{rawConfig: `{ "type": "GROUP", "history": { "size": 10 }, "tiles": [{ "type": "PING", "params": { "hostname": "server.com" } }] }`, errorID: models.ConfigErrorUnauthorizedField, fieldName: "history"},
+		{rawConfig: `{ "type": "PING", "history": { "interval": 1000 }, "params": { "hostname": "server.com" } }`, errorID: models.ConfigErrorMissingRequiredField, fieldName: "history.size"},
+		{rawConfig: `{ "type": "PING", "history": { "size": 10, "interval": -1 }, "params": { "hostname": "server.com" } }`, errorID: models.ConfigErrorInvalidFieldValue, fieldName: "history.interval"},
+	} {
+		tile, conf := initConfig(t, testcase.rawConfig)
+		usecase := initConfigUsecase(nil)
+		usecase.verifyTile(conf, tile, nil)
+
+		if assert.Len(t, conf.Errors, 1, testcase.rawConfig) {
+			assert.Equal(t, testcase.errorID, conf.Errors[0].ID)
+			assert.Equal(t, testcase.fieldName, conf.Errors[0].Data.FieldName)
+		}
+	}
+}
+
 func TestUsecase_VerifyTile_Failed(t *testing.T) {
 	for _, testcase := range []struct {
 		rawConfig string
@@ -245,6 +348,35 @@ func TestUsecase_VerifyTile_Failed(t *testing.T) {
 				ConfigExtractHighlight: `{"type":"GROUP"}`,
 			},
 		},
+		{
+			rawConfig: `
+					{ "type": "GROUP", "aggregate": "WORST", "tiles": [
+							{ "type": "SUMMARY", "params": { "tiles": [{ "type": "PING", "params": { "hostname": "server.com" } }] } }
+					]}
+		`,
+			errorID: models.ConfigErrorUnauthorizedSubtileType,
+			errorData: models.ConfigErrorData{
+				ConfigExtract:          `{"type":"GROUP","tiles":[{"type":"SUMMARY","params":{"tiles":[{"params":{"hostname":"server.com"},"type":"PING"}]}}],"aggregate":"WORST"}`,
+				ConfigExtractHighlight: `{"type":"SUMMARY","params":{"tiles":[{"params":{"hostname":"server.com"},"type":"PING"}]}}`,
+			},
+		},
+		{
+			rawConfig: `{ "type": "PING", "aggregate": "WORST", "params": { "hostname": "server.com" } }`,
+			errorID:   models.ConfigErrorUnauthorizedField,
+			errorData: models.ConfigErrorData{
+				FieldName:     "aggregate",
+				ConfigExtract: `{"type":"PING","aggregate":"WORST","params":{"hostname":"server.com"}}`,
+			},
+		},
+		{
+			rawConfig: `{ "type": "GROUP", "aggregate": "BEST", "tiles": [{ "type": "PING", "params": { "hostname": "server.com" } }]}`,
+			errorID:   models.ConfigErrorInvalidFieldValue,
+			errorData: models.ConfigErrorData{
+				FieldName:     "aggregate",
+				Expected:      "WORST, MAJORITY, FAILURES",
+				ConfigExtract: `{"type":"GROUP","tiles":[{"type":"PING","params":{"hostname":"server.com"}}],"aggregate":"BEST"}`,
+			},
+		},
 		{
 			rawConfig: `{ "type": "GROUP", "params": {"test": "test"}}`,
 			errorID:   models.ConfigErrorUnauthorizedField,
@@ -274,7 +406,7 @@ func TestUsecase_VerifyTile_Failed(t *testing.T) {
 			errorID:   models.ConfigErrorMissingRequiredField,
 			errorData: models.ConfigErrorData{
 				FieldName:     "params",
-				ConfigExtract: `{"type":"PING","configVariant":"default"}`,
+				ConfigExtract: `{"type":"PING","variant":"default"}`,
 			},
 		},
 		{
@@ -282,7 +414,7 @@ func TestUsecase_VerifyTile_Failed(t *testing.T) {
 			errorID:   models.ConfigErrorMissingRequiredField,
 			errorData: models.ConfigErrorData{
 				FieldName:     "hostname",
-				ConfigExtract: `{"type":"PING","configVariant":"default","params":{}}`,
+				ConfigExtract: `{"type":"PING","variant":"default","params":{}}`,
 			},
 		},
 		{
@@ -290,7 +422,7 @@ func TestUsecase_VerifyTile_Failed(t *testing.T) {
 			errorID:   models.ConfigErrorUnknownField,
 			errorData: models.ConfigErrorData{
 				FieldName:     "host",
-				ConfigExtract: `{"type":"PING","params":{"host":"server.com"},"configVariant":"default"}`,
+				ConfigExtract: `{"type":"PING","params":{"host":"server.com"},"variant":"default"}`,
 				Expected:      "hostname",
 			},
 		},
@@ -299,7 +431,7 @@ func TestUsecase_VerifyTile_Failed(t *testing.T) {
 			errorID:   models.ConfigErrorInvalidFieldValue,
 			errorData: models.ConfigErrorData{
 				FieldName:     "port",
-				ConfigExtract: `{"type":"PORT","params":{"hostname":"server.com","port":-20},"configVariant":"default"}`,
+				ConfigExtract: `{"type":"PORT","params":{"hostname":"server.com","port":-20},"variant":"default"}`,
 				Expected:      "port > 0",
 			},
 		},
@@ -308,16 +440,16 @@ func TestUsecase_VerifyTile_Failed(t *testing.T) {
 			errorID:   models.ConfigErrorUnexpectedError,
 			errorData: models.ConfigErrorData{
 				FieldName:     "params",
-				ConfigExtract: `{"type":"PING","params":{"hostname":["server.com"]},"configVariant":"default"}`,
+				ConfigExtract: `{"type":"PING","params":{"hostname":["server.com"]},"variant":"default"}`,
 			},
 		},
 		{
-			rawConfig: `{ "type": "JENKINS-BUILD", "configVariant": "disabledVariant", "params": { } }`,
+			rawConfig: `{ "type": "JENKINS-BUILD", "variant": "disabledVariant", "params": { } }`,
 			errorID:   models.ConfigErrorDisabledVariant,
 			errorData: models.ConfigErrorData{
-				FieldName:     "configVariant",
+				FieldName:     "variant",
 				Value:         `"disabledVariant"`,
-				ConfigExtract: `{"type":"JENKINS-BUILD","configVariant":"disabledVariant"}`,
+				ConfigExtract: `{"type":"JENKINS-BUILD","variant":"disabledVariant"}`,
 			},
 		},
 	} {
@@ -343,7 +475,7 @@ func TestUsecase_VerifyTile_Failed_WrongMinimalVerison(t *testing.T) {
 	if assert.Len(t, conf.Errors, 1) {
 		assert.Equal(t, models.ConfigErrorUnsupportedTileInThisVersion, conf.Errors[0].ID)
 		assert.Equal(t, "type", conf.Errors[0].Data.FieldName)
-		assert.Equal(t, `{"type":"PING","params":{"hostname":"server.com"},"configVariant":"default"}`, conf.Errors[0].Data.ConfigExtract)
+		assert.Equal(t, `{"type":"PING","params":{"hostname":"server.com"},"variant":"default"}`, conf.Errors[0].Data.ConfigExtract)
 		assert.Equal(t, `version >= "999.0"`, conf.Errors[0].Data.Expected)
 	}
 }
@@ -358,12 +490,12 @@ func TestUsecase_VerifyTile_Failed_WrongTileType(t *testing.T) {
 	if assert.Len(t, conf.Errors, 1) {
 		assert.Equal(t, models.ConfigErrorUnknownTileType, conf.Errors[0].ID)
 		assert.Equal(t, "type", conf.Errors[0].Data.FieldName)
-		assert.Equal(t, `{"type":"PONG","params":{"hostname":"server.com"},"configVariant":"default"}`, conf.Errors[0].Data.ConfigExtract)
+		assert.Equal(t, `{"type":"PONG","params":{"hostname":"server.com"},"variant":"default"}`, conf.Errors[0].Data.ConfigExtract)
 	}
 }
 
 func TestUsecase_VerifyTile_WithGenerator(t *testing.T) {
-	rawConfig := `{ "type": "GENERATE:JENKINS-BUILD", "configVariant": "default", "params": { "job": "job1" } }`
+	rawConfig := `{ "type": "GENERATE:JENKINS-BUILD", "variant": "default", "params": { "job": "job1" } }`
 
 	tile, conf := initConfig(t, rawConfig)
 	params := &jenkinsModels.BuildParams{Job: "test"}
@@ -392,13 +524,38 @@ func TestUsecase_VerifyTile_WithGenerator_WithWrongGenerator(t *testing.T) {
 	if assert.Len(t, conf.Errors, 1) {
 		assert.Equal(t, models.ConfigErrorUnknownGeneratorTileType, conf.Errors[0].ID)
 		assert.Equal(t, "type", conf.Errors[0].Data.FieldName)
-		assert.Equal(t, `{"type":"GENERATE:PING","configVariant":"default"}`, conf.Errors[0].Data.ConfigExtract)
+		assert.Equal(t, `{"type":"GENERATE:PING","variant":"default"}`, conf.Errors[0].Data.ConfigExtract)
 		assert.Equal(t, `GENERATE:JENKINS-BUILD`, conf.Errors[0].Data.Expected)
 	}
 }
 
+func TestUsecase_VerifyTile_WithParamsFactory(t *testing.T) {
+	schema := []pluginModels.ParamManifest{{Name: "queue", Type: pluginModels.StringParamType, Required: true}}
+
+	for _, testcase := range []struct {
+		rawConfig string
+		errorID   models.ConfigErrorID
+	}{
+		{rawConfig: `{ "type": "ACME-QUEUE", "params": { "queue": "orders" } }`},
+		{rawConfig: `{ "type": "ACME-QUEUE", "params": { "queue": 10 } }`, errorID: models.ConfigErrorInvalidFieldValue},
+		{rawConfig: `{ "type": "ACME-QUEUE", "params": { "queue": "orders", "unknown": true } }`, errorID: models.ConfigErrorUnknownField},
+	} {
+		tile, conf := initConfig(t, testcase.rawConfig)
+		usecase := initConfigUsecase(nil)
+		usecase.registry.RegisterTile("ACME-QUEUE", versions.MinimalVersion, []coreModels.VariantName{coreModels.DefaultVariantName}).
+			Enable(coreModels.DefaultVariantName, pluginModels.NewPluginParams(schema), "/plugin/default/acme-queue")
+		usecase.verifyTile(conf, tile, nil)
+
+		if testcase.errorID == "" {
+			assert.Len(t, conf.Errors, 0, testcase.rawConfig)
+		} else if assert.Len(t, conf.Errors, 1, testcase.rawConfig) {
+			assert.Equal(t, testcase.errorID, conf.Errors[0].ID)
+		}
+	}
+}
+
 func TestUsecase_VerifyTile_WithWrongVariant(t *testing.T) {
-	rawConfig := `{ "type": "JENKINS-BUILD", "configVariant": "test", "params": { "job": "job1" } }`
+	rawConfig := `{ "type": "JENKINS-BUILD", "variant": "test", "params": { "job": "job1" } }`
 
 	tile, conf := initConfig(t, rawConfig)
 
@@ -407,16 +564,16 @@ func TestUsecase_VerifyTile_WithWrongVariant(t *testing.T) {
 
 	if assert.Len(t, conf.Errors, 1) {
 		assert.Equal(t, models.ConfigErrorUnknownVariant, conf.Errors[0].ID)
-		assert.Equal(t, "configVariant", conf.Errors[0].Data.FieldName)
+		assert.Equal(t, "variant", conf.Errors[0].Data.FieldName)
 		assert.Equal(t, `"test"`, conf.Errors[0].Data.Value)
 		assert.Contains(t, conf.Errors[0].Data.Expected, coreModels.DefaultVariantName)
 		assert.Contains(t, conf.Errors[0].Data.Expected, "disabledVariant")
-		assert.Equal(t, `{"type":"JENKINS-BUILD","params":{"job":"job1"},"configVariant":"test"}`, conf.Errors[0].Data.ConfigExtract)
+		assert.Equal(t, `{"type":"JENKINS-BUILD","params":{"job":"job1"},"variant":"test"}`, conf.Errors[0].Data.ConfigExtract)
 	}
 }
 
 func TestUsecase_VerifyTile_WithGenerator_WithWrongVariant(t *testing.T) {
-	rawConfig := `{ "type": "GENERATE:JENKINS-BUILD", "configVariant": "test", "params": { "job": "job1" } }`
+	rawConfig := `{ "type": "GENERATE:JENKINS-BUILD", "variant": "test", "params": { "job": "job1" } }`
 
 	tile, conf := initConfig(t, rawConfig)
 	params := &jenkinsModels.BuildParams{Job: "test"}
@@ -431,10 +588,10 @@ func TestUsecase_VerifyTile_WithGenerator_WithWrongVariant(t *testing.T) {
 
 	if assert.Len(t, conf.Errors, 1) {
 		assert.Equal(t, models.ConfigErrorUnknownVariant, conf.Errors[0].ID)
-		assert.Equal(t, "configVariant", conf.Errors[0].Data.FieldName)
+		assert.Equal(t, "variant", conf.Errors[0].Data.FieldName)
 		assert.Equal(t, `"test"`, conf.Errors[0].Data.Value)
 		assert.Contains(t, conf.Errors[0].Data.Expected, coreModels.DefaultVariantName)
-		assert.Equal(t, `{"type":"GENERATE:JENKINS-BUILD","params":{"job":"job1"},"configVariant":"test"}`, conf.Errors[0].Data.ConfigExtract)
+		assert.Equal(t, `{"type":"GENERATE:JENKINS-BUILD","params":{"job":"job1"},"variant":"test"}`, conf.Errors[0].Data.ConfigExtract)
 	}
 }
 
@@ -457,6 +614,6 @@ func TestUsecase_VerifyTile_FieldMinimalVersion(t *testing.T) {
 		assert.Equal(t, models.ConfigErrorUnsupportedTileParamInThisVersion, conf.Errors[0].ID)
 		assert.Equal(t, "field1", conf.Errors[0].Data.FieldName)
 		assert.Equal(t, "version >= 999.0", conf.Errors[0].Data.Expected)
-		assert.Equal(t, `{"type":"TEST","params":{"field1":"server.com"},"configVariant":"default"}`, conf.Errors[0].Data.ConfigExtract)
+		assert.Equal(t, `{"type":"TEST","params":{"field1":"server.com"},"variant":"default"}`, conf.Errors[0].Data.ConfigExtract)
 	}
 }
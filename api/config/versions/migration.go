@@ -0,0 +1,105 @@
+package versions
+
+import "fmt"
+
+// ----------------------------------------------------------------
+// --------------------- AVAILABLE MIGRATIONS ---------------------
+// Each new version register the transformation applied on config written in the previous version.
+var migrations = []*Migration{
+	{
+		Version: Version2001,
+		Deprecations: []*Deprecation{
+			{Field: "configVariant", Replacement: "variant"},
+		},
+		Transform: func(rawConfig map[string]interface{}) {
+			renameTilesField(rawConfig, "configVariant", "variant")
+		},
+	},
+}
+
+// ----------------------------------------------------------------
+// ----------------------------------------------------------------
+
+type (
+	// Migration upgrade raw config from previous version to Version
+	Migration struct {
+		// Version reached after this migration
+		Version RawVersion
+		// Deprecations list constructs replaced by this migration. Used to suggest replacement in errors
+		Deprecations []*Deprecation
+		// Transform update raw config in place
+		Transform func(rawConfig map[string]interface{})
+	}
+
+	// Deprecation describe a config construct removed in a version
+	Deprecation struct {
+		Field       string
+		Replacement string
+	}
+)
+
+// Migrate upgrade rawConfig in place to CurrentVersion and return applied migrations.
+// rawConfig without valid version or outside supported versions is left untouched (verify will report it).
+func Migrate(rawConfig map[string]interface{}) []*Migration {
+	rawVersion, ok := rawConfig["version"].(string)
+	if !ok {
+		return nil
+	}
+
+	version := &ConfigVersion{}
+	if err := version.UnmarshalJSON([]byte(fmt.Sprintf("%q", rawVersion))); err != nil {
+		return nil
+	}
+
+	if version.IsLessThan(MinimalVersion) || version.IsGreaterThanOrEqualTo(CurrentVersion) {
+		return nil
+	}
+
+	var applied []*Migration
+	for _, migration := range migrations {
+		if version.IsLessThan(migration.Version) {
+			migration.Transform(rawConfig)
+			rawConfig["version"] = string(migration.Version)
+			applied = append(applied, migration)
+		}
+	}
+
+	return applied
+}
+
+// LookupDeprecation find the migration that replaced field
+func LookupDeprecation(field string) (*Migration, *Deprecation) {
+	for _, migration := range migrations {
+		for _, deprecation := range migration.Deprecations {
+			if deprecation.Field == field {
+				return migration, deprecation
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// renameTilesField rename field in every tiles and sub-tiles of rawConfig
+func renameTilesField(rawConfig map[string]interface{}, field, newField string) {
+	tiles, ok := rawConfig["tiles"].([]interface{})
+	if !ok {
+		return
+	}
+
+	for _, rawTile := range tiles {
+		tile, ok := rawTile.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if value, exists := tile[field]; exists {
+			if _, exists := tile[newField]; !exists {
+				tile[newField] = value
+			}
+			delete(tile, field)
+		}
+
+		renameTilesField(tile, field, newField)
+	}
+}
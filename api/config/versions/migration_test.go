@@ -0,0 +1,72 @@
+package versions
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMigrate(t *testing.T) {
+	for _, testcase := range []struct {
+		rawConfig       string
+		expectedConfig  string
+		expectedApplied []RawVersion
+	}{
+		{
+			rawConfig:       `{"version":"2.0","tiles":[{"type":"PING","configVariant":"test"},{"type":"GROUP","tiles":[{"type":"PING","configVariant":"test"}]}]}`,
+			expectedConfig:  `{"tiles":[{"type":"PING","variant":"test"},{"tiles":[{"type":"PING","variant":"test"}],"type":"GROUP"}],"version":"2.1"}`,
+			expectedApplied: []RawVersion{Version2001},
+		},
+		{
+			rawConfig:       `{"version":"2.0","tiles":[{"type":"PING","configVariant":"test","variant":"other"}]}`,
+			expectedConfig:  `{"tiles":[{"type":"PING","variant":"other"}],"version":"2.1"}`,
+			expectedApplied: []RawVersion{Version2001},
+		},
+		{
+			rawConfig:      `{"version":"2.1","tiles":[{"type":"PING","configVariant":"test"}]}`,
+			expectedConfig: `{"tiles":[{"configVariant":"test","type":"PING"}],"version":"2.1"}`,
+		},
+		{
+			rawConfig:      `{"version":"1.0","tiles":[{"type":"PING","configVariant":"test"}]}`,
+			expectedConfig: `{"tiles":[{"configVariant":"test","type":"PING"}],"version":"1.0"}`,
+		},
+		{
+			rawConfig:      `{"version":"test"}`,
+			expectedConfig: `{"version":"test"}`,
+		},
+		{
+			rawConfig:      `{"version":2}`,
+			expectedConfig: `{"version":2}`,
+		},
+		{
+			rawConfig:       `{"version":"2.0","tiles":"test"}`,
+			expectedConfig:  `{"tiles":"test","version":"2.1"}`,
+			expectedApplied: []RawVersion{Version2001},
+		},
+	} {
+		rawConfig := make(map[string]interface{})
+		assert.NoError(t, json.Unmarshal([]byte(testcase.rawConfig), &rawConfig))
+
+		var applied []RawVersion
+		for _, migration := range Migrate(rawConfig) {
+			applied = append(applied, migration.Version)
+		}
+		assert.Equal(t, testcase.expectedApplied, applied)
+
+		bytes, _ := json.Marshal(rawConfig)
+		assert.Equal(t, testcase.expectedConfig, string(bytes))
+	}
+}
+
+func TestLookupDeprecation(t *testing.T) {
+	migration, deprecation := LookupDeprecation("configVariant")
+	if assert.NotNil(t, deprecation) {
+		assert.Equal(t, Version2001, migration.Version)
+		assert.Equal(t, "variant", deprecation.Replacement)
+	}
+
+	migration, deprecation = LookupDeprecation("test")
+	assert.Nil(t, migration)
+	assert.Nil(t, deprecation)
+}
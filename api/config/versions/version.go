@@ -9,11 +9,15 @@ import (
 
 // ----------------------------------------------------------------
 // ---------------------- AVAILABLE VERSIONS ----------------------
+// A new version is only needed for breaking changes of the config format, handled by a migration (see migration.go).
+// Configs are upgraded to CurrentVersion before being verified, so new tile types and new optional fields are
+// registered with MinimalVersion and don't use "available" tag.
 const (
-	CurrentVersion = Version2000
+	CurrentVersion = Version2001
 	MinimalVersion = Version2000
 
 	Version2000 RawVersion = "2.0" // Initial version
+	Version2001 RawVersion = "2.1" // Rename "configVariant" into "variant"
 )
 
 // ----------------------------------------------------------------
@@ -0,0 +1,249 @@
+package group
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/monitoror/monitoror/api/config/models"
+	"github.com/monitoror/monitoror/api/config/usecase"
+	coreModels "github.com/monitoror/monitoror/models"
+
+	"github.com/labstack/echo/v4"
+)
+
+type (
+	HTTPGroupDelivery struct {
+		// handler used to fetch sub-tiles without leaving the core (usually the echo server)
+		handler http.Handler
+		// apiPrefix prepended to sub-tiles URL
+		apiPrefix string
+	}
+
+	// tileRecorder keep sub-tile response in memory
+	tileRecorder struct {
+		header http.Header
+		code   int
+		body   bytes.Buffer
+	}
+)
+
+// orderedTileStatus from the least to the most important status. Same order as the UI
+var orderedTileStatus = []coreModels.TileStatus{
+	coreModels.UnknownStatus,
+	coreModels.DisabledStatus,
+	coreModels.SuccessStatus,
+	coreModels.ActionRequiredStatus,
+	coreModels.CanceledStatus,
+	coreModels.WarningStatus,
+	coreModels.FailedStatus,
+	coreModels.QueuedStatus,
+	coreModels.RunningStatus,
+}
+
+func NewHTTPGroupDelivery(handler http.Handler, apiPrefix string) *HTTPGroupDelivery {
+	return &HTTPGroupDelivery{handler: handler, apiPrefix: apiPrefix}
+}
+
+func (h *HTTPGroupDelivery) GetGroupStatus(c echo.Context) error {
+	aggregate := models.GroupAggregate(c.QueryParam(usecase.GroupStatusAggregateQueryParam))
+	switch aggregate {
+	case models.WorstGroupAggregate, models.MajorityGroupAggregate, models.FailuresGroupAggregate:
+	default:
+		return &coreModels.MonitororError{Message: fmt.Sprintf("invalid %s query param, must be one of %s, %s, %s",
+			usecase.GroupStatusAggregateQueryParam, models.WorstGroupAggregate, models.MajorityGroupAggregate, models.FailuresGroupAggregate)}
+	}
+
+	urls, err := subTilesURLs(c)
+	if err != nil {
+		return err
+	}
+	if len(urls) == 0 {
+		return &coreModels.MonitororError{Message: fmt.Sprintf("missing %s query param", usecase.GroupStatusURLQueryParam)}
+	}
+
+	tile := aggregateTiles(aggregate, h.fetchTiles(c.Request(), urls))
+	return c.JSON(http.StatusOK, tile)
+}
+
+func (h *HTTPGroupDelivery) GetSummary(c echo.Context) error {
+	urls, err := subTilesURLs(c)
+	if err != nil {
+		return err
+	}
+
+	tile := summarizeTiles(h.fetchTiles(c.Request(), urls))
+	return c.JSON(http.StatusOK, tile)
+}
+
+// subTilesURLs return sub-tiles URL from query params. Only local tiles endpoints are allowed, without recursion,
+// and at most usecase.MaxSubTilesURL of them
+func subTilesURLs(c echo.Context) ([]string, error) {
+	urls := c.QueryParams()[usecase.GroupStatusURLQueryParam]
+	if len(urls) > usecase.MaxSubTilesURL {
+		return nil, echo.NewHTTPError(http.StatusBadRequest,
+			fmt.Sprintf("too many %s query params, maximum is %d", usecase.GroupStatusURLQueryParam, usecase.MaxSubTilesURL))
+	}
+	for _, url := range urls {
+		if !strings.HasPrefix(url, "/") || strings.HasPrefix(url, "//") ||
+			strings.HasPrefix(url, usecase.GroupStatusRoutePath) || strings.HasPrefix(url, usecase.SummaryRoutePath) {
+			return nil, &coreModels.MonitororError{Message: fmt.Sprintf("invalid %s query param: %s", usecase.GroupStatusURLQueryParam, url)}
+		}
+	}
+	return urls, nil
+}
+
+// fetchTiles call every sub-tile endpoint in parallel. nil is returned for sub-tile in error
+func (h *HTTPGroupDelivery) fetchTiles(request *http.Request, urls []string) []*coreModels.Tile {
+	tiles := make([]*coreModels.Tile, len(urls))
+
+	var wg sync.WaitGroup
+	for i, url := range urls {
+		wg.Add(1)
+		go func(i int, url string) {
+			defer wg.Done()
+
+			req, err := http.NewRequestWithContext(request.Context(), http.MethodGet, h.apiPrefix+url, nil)
+			if err != nil {
+				return
+			}
+			res := &tileRecorder{header: make(http.Header), code: http.StatusOK}
+			h.handler.ServeHTTP(res, req)
+
+			if res.code != http.StatusOK {
+				return
+			}
+
+			tile := &coreModels.Tile{}
+			if err := json.Unmarshal(res.body.Bytes(), tile); err != nil {
+				return
+			}
+			tiles[i] = tile
+		}(i, url)
+	}
+	wg.Wait()
+
+	return tiles
+}
+
+// aggregateTiles summarize sub-tiles into one GROUP tile
+func aggregateTiles(aggregate models.GroupAggregate, tiles []*coreModels.Tile) *coreModels.Tile {
+	groupTile := coreModels.NewTile(usecase.GroupTileType)
+
+	var statuses []coreModels.TileStatus
+	failures := 0
+	for _, tile := range tiles {
+		status := subTileStatus(tile)
+		if status == coreModels.FailedStatus {
+			failures++
+		}
+		statuses = append(statuses, status)
+	}
+
+	switch aggregate {
+	case models.WorstGroupAggregate:
+		groupTile.Status = worstStatus(statuses)
+	case models.MajorityGroupAggregate:
+		groupTile.Status = majorityStatus(statuses)
+	case models.FailuresGroupAggregate:
+		groupTile.Status = coreModels.SuccessStatus
+		if failures > 0 {
+			groupTile.Status = coreModels.FailedStatus
+		}
+		groupTile.WithValue(coreModels.NumberUnit)
+		groupTile.Value.Values = append(groupTile.Value.Values, fmt.Sprintf("%d", failures))
+	}
+
+	groupTile.Message = fmt.Sprintf("%d/%d failing", failures, len(tiles))
+
+	return groupTile
+}
+
+// summarizeTiles return a SUMMARY tile with the worst status of sub-tiles.
+// Value is the count of failing sub-tiles (like FAILURES aggregate), so history and thresholds apply on it. Successful count is only in message
+func summarizeTiles(tiles []*coreModels.Tile) *coreModels.Tile {
+	summaryTile := coreModels.NewTile(usecase.SummaryTileType).WithValue(coreModels.NumberUnit)
+
+	var statuses []coreModels.TileStatus
+	successes, failures := 0, 0
+	for _, tile := range tiles {
+		status := subTileStatus(tile)
+		switch status {
+		case coreModels.SuccessStatus:
+			successes++
+		case coreModels.FailedStatus:
+			failures++
+		}
+		statuses = append(statuses, status)
+	}
+
+	summaryTile.Status = worstStatus(statuses)
+	summaryTile.Message = fmt.Sprintf("%d OK, %d failing", successes, failures)
+	summaryTile.Value.Values = append(summaryTile.Value.Values, fmt.Sprintf("%d", failures))
+
+	return summaryTile
+}
+
+// subTileStatus return status of sub-tile, or previous status when build is running
+func subTileStatus(tile *coreModels.Tile) coreModels.TileStatus {
+	if tile == nil || tile.Status == "" {
+		return coreModels.UnknownStatus
+	}
+
+	if (tile.Status == coreModels.QueuedStatus || tile.Status == coreModels.RunningStatus) &&
+		tile.Build != nil && tile.Build.PreviousStatus != "" {
+		return tile.Build.PreviousStatus
+	}
+
+	return tile.Status
+}
+
+func worstStatus(statuses []coreModels.TileStatus) coreModels.TileStatus {
+	worst := coreModels.UnknownStatus
+	for _, status := range statuses {
+		if statusIndex(status) > statusIndex(worst) {
+			worst = status
+		}
+	}
+	return worst
+}
+
+// majorityStatus return the most common status. In case of equality, the most important status is returned
+func majorityStatus(statuses []coreModels.TileStatus) coreModels.TileStatus {
+	counts := make(map[coreModels.TileStatus]int)
+	for _, status := range statuses {
+		counts[status]++
+	}
+
+	majority := coreModels.UnknownStatus
+	for status, count := range counts {
+		if count > counts[majority] || (count == counts[majority] && statusIndex(status) > statusIndex(majority)) {
+			majority = status
+		}
+	}
+	return majority
+}
+
+func statusIndex(status coreModels.TileStatus) int {
+	for i, s := range orderedTileStatus {
+		if s == status {
+			return i
+		}
+	}
+	return -1
+}
+
+func (r *tileRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *tileRecorder) WriteHeader(code int) {
+	r.code = code
+}
+
+func (r *tileRecorder) Write(b []byte) (int, error) {
+	return r.body.Write(b)
+}
@@ -0,0 +1,162 @@
+package group
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/monitoror/monitoror/api/config/models"
+	"github.com/monitoror/monitoror/api/config/usecase"
+	coreModels "github.com/monitoror/monitoror/models"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func initGroupEcho(query string) (e *echo.Echo, ctx echo.Context, res *httptest.ResponseRecorder) {
+	e = echo.New()
+	e.GET("/api/v1/success", func(c echo.Context) error {
+		return c.JSON(http.StatusOK, &coreModels.Tile{Type: "PING", Status: coreModels.SuccessStatus})
+	})
+	e.GET("/api/v1/failure", func(c echo.Context) error {
+		return c.JSON(http.StatusOK, &coreModels.Tile{Type: "PING", Status: coreModels.FailedStatus})
+	})
+	e.GET("/api/v1/error", func(c echo.Context) error {
+		return echo.ErrInternalServerError
+	})
+
+	req := httptest.NewRequest(echo.GET, "/api/v1/groups/status"+query, nil)
+	res = httptest.NewRecorder()
+	ctx = e.NewContext(req, res)
+
+	return
+}
+
+func TestGetGroupStatus(t *testing.T) {
+	e, ctx, res := initGroupEcho("?aggregate=WORST&url=/success&url=/failure&url=/success&url=/error")
+	handler := NewHTTPGroupDelivery(e, "/api/v1")
+
+	if assert.NoError(t, handler.GetGroupStatus(ctx)) {
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		tile := &coreModels.Tile{}
+		assert.NoError(t, json.Unmarshal(res.Body.Bytes(), tile))
+		assert.Equal(t, usecase.GroupTileType, tile.Type)
+		assert.Equal(t, coreModels.FailedStatus, tile.Status)
+		assert.Equal(t, "1/4 failing", tile.Message)
+	}
+}
+
+func TestGetGroupStatus_WithError(t *testing.T) {
+	for _, query := range []string{
+		"?url=/success",
+		"?aggregate=BEST&url=/success",
+		"?aggregate=WORST",
+		"?aggregate=WORST&url=http://monitoror.com",
+		"?aggregate=WORST&url=//monitoror.com",
+		"?aggregate=WORST&url=/groups/status",
+	} {
+		e, ctx, _ := initGroupEcho(query)
+		handler := NewHTTPGroupDelivery(e, "/api/v1")
+
+		assert.Error(t, handler.GetGroupStatus(ctx), query)
+	}
+}
+
+func TestGetSummary(t *testing.T) {
+	e, ctx, res := initGroupEcho("?url=/success&url=/failure&url=/success&url=/error")
+	handler := NewHTTPGroupDelivery(e, "/api/v1")
+
+	if assert.NoError(t, handler.GetSummary(ctx)) {
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		tile := &coreModels.Tile{}
+		assert.NoError(t, json.Unmarshal(res.Body.Bytes(), tile))
+		assert.Equal(t, usecase.SummaryTileType, tile.Type)
+		assert.Equal(t, coreModels.FailedStatus, tile.Status)
+		assert.Equal(t, "2 OK, 1 failing", tile.Message)
+		assert.Equal(t, &coreModels.TileValue{Values: []string{"1"}, Unit: coreModels.NumberUnit}, tile.Value)
+	}
+}
+
+func TestGetSummary_WithError(t *testing.T) {
+	e, ctx, _ := initGroupEcho("?url=/summary")
+	handler := NewHTTPGroupDelivery(e, "/api/v1")
+
+	assert.Error(t, handler.GetSummary(ctx))
+}
+
+func TestGetSummary_TooManyURL(t *testing.T) {
+	query := "?url=/success" + strings.Repeat("&url=/success", usecase.MaxSubTilesURL)
+	e, ctx, _ := initGroupEcho(query)
+	handler := NewHTTPGroupDelivery(e, "/api/v1")
+
+	err := handler.GetSummary(ctx)
+	if assert.Error(t, err) {
+		httpErr, ok := err.(*echo.HTTPError)
+		if assert.True(t, ok) {
+			assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+		}
+	}
+}
+
+func TestAggregateTiles(t *testing.T) {
+	success := &coreModels.Tile{Status: coreModels.SuccessStatus}
+	failure := &coreModels.Tile{Status: coreModels.FailedStatus}
+	warning := &coreModels.Tile{Status: coreModels.WarningStatus}
+	runningAfterFailure := &coreModels.Tile{Status: coreModels.RunningStatus, Build: &coreModels.TileBuild{PreviousStatus: coreModels.FailedStatus}}
+
+	for _, testcase := range []struct {
+		aggregate       models.GroupAggregate
+		tiles           []*coreModels.Tile
+		expectedStatus  coreModels.TileStatus
+		expectedMessage string
+		expectedValue   *coreModels.TileValue
+	}{
+		{
+			aggregate:       models.WorstGroupAggregate,
+			tiles:           []*coreModels.Tile{success, warning, success},
+			expectedStatus:  coreModels.WarningStatus,
+			expectedMessage: "0/3 failing",
+		},
+		{
+			aggregate:       models.WorstGroupAggregate,
+			tiles:           []*coreModels.Tile{success, runningAfterFailure, nil},
+			expectedStatus:  coreModels.FailedStatus,
+			expectedMessage: "1/3 failing",
+		},
+		{
+			aggregate:       models.MajorityGroupAggregate,
+			tiles:           []*coreModels.Tile{success, failure, success},
+			expectedStatus:  coreModels.SuccessStatus,
+			expectedMessage: "1/3 failing",
+		},
+		{
+			aggregate:       models.MajorityGroupAggregate,
+			tiles:           []*coreModels.Tile{success, failure},
+			expectedStatus:  coreModels.FailedStatus,
+			expectedMessage: "1/2 failing",
+		},
+		{
+			aggregate:       models.FailuresGroupAggregate,
+			tiles:           []*coreModels.Tile{success, warning},
+			expectedStatus:  coreModels.SuccessStatus,
+			expectedMessage: "0/2 failing",
+			expectedValue:   &coreModels.TileValue{Values: []string{"0"}, Unit: coreModels.NumberUnit},
+		},
+		{
+			aggregate:       models.FailuresGroupAggregate,
+			tiles:           []*coreModels.Tile{failure, success, failure},
+			expectedStatus:  coreModels.FailedStatus,
+			expectedMessage: "2/3 failing",
+			expectedValue:   &coreModels.TileValue{Values: []string{"2"}, Unit: coreModels.NumberUnit},
+		},
+	} {
+		tile := aggregateTiles(testcase.aggregate, testcase.tiles)
+		assert.Equal(t, testcase.expectedStatus, tile.Status)
+		assert.Equal(t, testcase.expectedMessage, tile.Message)
+		assert.Equal(t, testcase.expectedValue, tile.Value)
+	}
+}
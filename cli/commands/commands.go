@@ -2,12 +2,15 @@ package commands
 
 import (
 	"github.com/monitoror/monitoror/cli"
+	configCmd "github.com/monitoror/monitoror/cli/commands/config"
 	initCmd "github.com/monitoror/monitoror/cli/commands/init"
 	"github.com/monitoror/monitoror/cli/commands/version"
 )
 
 func AddCommands(cli *cli.MonitororCli) {
 	cli.RootCmd.AddCommand(
+		// CONFIG
+		configCmd.NewConfigCommand(cli),
 		// INIT
 		initCmd.NewInitCommand(cli),
 		// VERSION
@@ -15,6 +15,7 @@ func TestAddCommands(t *testing.T) {
 
 	AddCommands(cli)
 
-	assert.Equal(t, "init", command.Commands()[0].Use)
-	assert.Equal(t, "version", command.Commands()[1].Use)
+	assert.Equal(t, "config", command.Commands()[0].Use)
+	assert.Equal(t, "init", command.Commands()[1].Use)
+	assert.Equal(t, "version", command.Commands()[2].Use)
 }
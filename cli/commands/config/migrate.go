@@ -0,0 +1,160 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"text/template"
+
+	configRepository "github.com/monitoror/monitoror/api/config/repository"
+	"github.com/monitoror/monitoror/api/config/versions"
+	"github.com/monitoror/monitoror/cli"
+	coreConfig "github.com/monitoror/monitoror/config"
+	"github.com/monitoror/monitoror/internal/pkg/path"
+	"github.com/monitoror/monitoror/internal/pkg/validator/validate"
+	"github.com/monitoror/monitoror/pkg/templates"
+
+	"github.com/spf13/cobra"
+)
+
+const migrateTemplate = `{{ range . -}}
+{{ if .Error -}}
+{{ "x " | red }}{{ .FilePath }} {{ .Error | red }}
+{{ else if not .Migrations -}}
+{{ "✓ " | green }}{{ .FilePath }} {{ "already up to date" | grey }}
+{{ else -}}
+{{ "✓ " | green }}{{ .FilePath }} {{ printf "migrated from %q to %q" .FromVersion .ToVersion | grey }}
+{{- range .Migrations }}{{ range .Deprecations }}
+    {{ printf "%q replaced by %q" .Field .Replacement }}
+{{- end }}{{ end }}
+{{ end -}}
+{{ end -}}
+`
+
+type migrateResult struct {
+	FilePath    string
+	FromVersion string
+	ToVersion   string
+	Migrations  []*versions.Migration
+	Error       error
+}
+
+var (
+	parsedMigrateTemplate *template.Template
+	urlRegex              *regexp.Regexp
+)
+
+func init() {
+	// Print this error when you want to debug template
+	parsedMigrateTemplate, _ = templates.New("migrate").Parse(migrateTemplate)
+
+	urlRegex = regexp.MustCompile(validate.HTTPRegex)
+}
+
+func NewMigrateCommand(monitororCli *cli.MonitororCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate [FILE...]",
+		Short: fmt.Sprintf("Rewrite config files in version %q. Without FILE, migrate every named config file and config directory", versions.CurrentVersion),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMigrate(monitororCli, path.MonitororBaseDir, args)
+		},
+	}
+	return cmd
+}
+
+func runMigrate(monitororCli *cli.MonitororCli, basedir string, filePaths []string) error {
+	if len(filePaths) == 0 {
+		// Use named configs stored in files
+		for _, namedConfig := range monitororCli.Store.CoreConfig.NamedConfigs {
+			if !urlRegex.MatchString(namedConfig) {
+				filePaths = append(filePaths, path.ToAbsolute(basedir, namedConfig))
+			}
+		}
+		// And configs found in config directory
+		if configDir := monitororCli.Store.CoreConfig.ConfigDir; configDir != "" {
+			for name, namedConfig := range coreConfig.NewNamedConfigDir(path.ToAbsolute(basedir, configDir)).NamedConfigs() {
+				if _, ok := monitororCli.Store.CoreConfig.NamedConfigs[name]; !ok {
+					filePaths = append(filePaths, namedConfig)
+				}
+			}
+		}
+		sort.Strings(filePaths)
+	} else {
+		for i := range filePaths {
+			filePaths[i], _ = filepath.Abs(filePaths[i])
+		}
+	}
+
+	var results []*migrateResult
+	var errorCount int
+	for _, filePath := range filePaths {
+		result := migrateFile(filePath)
+		if result.Error != nil {
+			errorCount++
+		}
+		results = append(results, result)
+	}
+
+	if err := parsedMigrateTemplate.Execute(monitororCli.Output, results); err != nil {
+		return err
+	}
+
+	if errorCount > 0 {
+		return fmt.Errorf("unable to migrate %d config file(s)", errorCount)
+	}
+
+	return nil
+}
+
+func migrateFile(filePath string) (result *migrateResult) {
+	result = &migrateResult{FilePath: filePath}
+
+	fileInfo, err := os.Stat(filePath)
+	if err != nil {
+		result.Error = err
+		return
+	}
+
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		result.Error = err
+		return
+	}
+
+	// Apply migrations on raw config
+	var rawConfig map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber() // Keep numbers as they are written
+	if err = decoder.Decode(&rawConfig); err != nil {
+		result.Error = err
+		return
+	}
+
+	result.FromVersion = fmt.Sprint(rawConfig["version"])
+	if result.Migrations = versions.Migrate(rawConfig); len(result.Migrations) == 0 {
+		return
+	}
+	result.ToVersion = fmt.Sprint(rawConfig["version"])
+
+	// Check that migrated config is valid and use Config struct to keep fields order
+	migratedData, _ := json.Marshal(rawConfig)
+	config, err := configRepository.ReadConfig(bytes.NewReader(migratedData))
+	if err != nil {
+		result.Error = err
+		return
+	}
+
+	buffer := &bytes.Buffer{}
+	encoder := json.NewEncoder(buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	_ = encoder.Encode(config) // Ignoring error, assuming there is no function or channel inside this struct
+
+	result.Error = ioutil.WriteFile(filePath, buffer.Bytes(), fileInfo.Mode())
+	return
+}
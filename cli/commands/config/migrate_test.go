@@ -0,0 +1,124 @@
+package config
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/monitoror/monitoror/cli"
+	coreConfig "github.com/monitoror/monitoror/config"
+	"github.com/monitoror/monitoror/store"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunMigrate(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "migrateCommand")
+	if assert.NoError(t, err) {
+		defer os.RemoveAll(tmpDir)
+
+		_ = ioutil.WriteFile(filepath.Join(tmpDir, "old.json"), []byte(`{"version": "2.0", "columns": 2, "tiles": [
+			{"type": "PORT", "configVariant": "test", "params": {"hostname": "127.0.0.1", "port": 8080}},
+			{"type": "GROUP", "label": "<group>", "tiles": [{"type": "PING", "configVariant": "test", "params": {"hostname": "127.0.0.1"}}]}
+		]}`), 0644)
+		_ = ioutil.WriteFile(filepath.Join(tmpDir, "current.json"), []byte(`{"version": "2.1", "columns": 2, "tiles": [{"type": "EMPTY"}]}`), 0644)
+
+		output := &bytes.Buffer{}
+		monitororCli := &cli.MonitororCli{
+			Output: output,
+			Store: &store.Store{CoreConfig: &coreConfig.CoreConfig{NamedConfigs: map[coreConfig.ConfigName]string{
+				"default": "old.json",
+				"current": "current.json",
+				"remote":  "https://monitoror.example.com/config.json",
+			}}},
+		}
+
+		assert.NoError(t, runMigrate(monitororCli, tmpDir, nil))
+		assert.Contains(t, output.String(), "current.json")
+		assert.Contains(t, output.String(), "already up to date")
+		assert.Contains(t, output.String(), "old.json")
+		assert.Contains(t, output.String(), `migrated from "2.0" to "2.1"`)
+		assert.Contains(t, output.String(), `"configVariant" replaced by "variant"`)
+		assert.NotContains(t, output.String(), "monitoror.example.com")
+
+		expected := `{
+  "version": "2.1",
+  "columns": 2,
+  "tiles": [
+    {
+      "type": "PORT",
+      "params": {
+        "hostname": "127.0.0.1",
+        "port": 8080
+      },
+      "variant": "test"
+    },
+    {
+      "type": "GROUP",
+      "label": "<group>",
+      "tiles": [
+        {
+          "type": "PING",
+          "params": {
+            "hostname": "127.0.0.1"
+          },
+          "variant": "test"
+        }
+      ]
+    }
+  ]
+}
+`
+		data, _ := ioutil.ReadFile(filepath.Join(tmpDir, "old.json"))
+		assert.Equal(t, expected, string(data))
+	}
+}
+
+func TestRunMigrate_WithConfigDir(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "migrateCommand")
+	if assert.NoError(t, err) {
+		defer os.RemoveAll(tmpDir)
+
+		_ = os.Mkdir(filepath.Join(tmpDir, "screens"), 0755)
+		_ = ioutil.WriteFile(filepath.Join(tmpDir, "screens", "screen1.json"), []byte(`{"version": "2.0", "columns": 2, "tiles": [{"type": "EMPTY"}]}`), 0644)
+		_ = ioutil.WriteFile(filepath.Join(tmpDir, "screens", "screen2.json"), []byte(`{"version": "2.1", "columns": 2, "tiles": [{"type": "EMPTY"}]}`), 0644)
+
+		output := &bytes.Buffer{}
+		monitororCli := &cli.MonitororCli{
+			Output: output,
+			Store: &store.Store{CoreConfig: &coreConfig.CoreConfig{
+				ConfigDir:    "screens",
+				NamedConfigs: map[coreConfig.ConfigName]string{},
+			}},
+		}
+
+		assert.NoError(t, runMigrate(monitororCli, tmpDir, nil))
+		assert.Contains(t, output.String(), filepath.Join(tmpDir, "screens", "screen1.json")+` migrated from "2.0" to "2.1"`)
+		assert.Contains(t, output.String(), filepath.Join(tmpDir, "screens", "screen2.json")+" already up to date")
+	}
+}
+
+func TestRunMigrate_Error(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "migrateCommand")
+	if assert.NoError(t, err) {
+		defer os.RemoveAll(tmpDir)
+
+		_ = ioutil.WriteFile(filepath.Join(tmpDir, "wrong.json"), []byte(`{"version": "2.0", "unknown": true}`), 0644)
+
+		output := &bytes.Buffer{}
+		monitororCli := &cli.MonitororCli{Output: output}
+
+		err := runMigrate(monitororCli, tmpDir, []string{filepath.Join(tmpDir, "wrong.json"), filepath.Join(tmpDir, "missing.json")})
+		assert.EqualError(t, err, "unable to migrate 2 config file(s)")
+		assert.Contains(t, output.String(), `json: unknown field "unknown"`)
+		assert.Contains(t, output.String(), "no such file or directory")
+	}
+}
+
+func TestNewConfigCommand(t *testing.T) {
+	cmd := NewConfigCommand(&cli.MonitororCli{})
+	assert.Equal(t, "config", cmd.Use)
+	assert.Equal(t, "migrate", cmd.Commands()[0].Name())
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/monitoror/monitoror/cli"
 	"github.com/monitoror/monitoror/cli/version"
 	coreConfig "github.com/monitoror/monitoror/config"
+	"github.com/monitoror/monitoror/internal/pkg/path"
 	coreModels "github.com/monitoror/monitoror/models"
 	"github.com/monitoror/monitoror/pkg/system"
 	"github.com/monitoror/monitoror/pkg/templates"
@@ -66,6 +67,14 @@ Check the documentation to know how to enabled them:
   {{ .Name }}{{ printf " -> %s" .Value | grey }}
 {{- end }}
 
+{{ end }}
+{{- with .ErroredRemoteConfigs }}
+{{ "ERRORED REMOTE CONFIGURATIONS" | red }}
+{{ range . }}
+  {{ printf "/!\\ Errored %q remote configuration" .Name | red }}
+    {{ .Error }}
+{{- end }}
+
 {{ end }}
 ─────────────────────────────────────────────────
 
@@ -87,6 +96,8 @@ type (
 		DisableUI     bool   // From .env
 		NamedConfigs  []namedConfigInfo
 		Monitorables  []monitorableInfo
+
+		ErroredRemoteConfigs []remoteConfigInfo
 	}
 
 	namedConfigInfo struct {
@@ -94,6 +105,11 @@ type (
 		Value string
 	}
 
+	remoteConfigInfo struct {
+		Name  string
+		Error error
+	}
+
 	monitorableInfo struct {
 		MonitorableName string     // From registry
 		EnabledVariants []struct { // From registry
@@ -126,8 +142,25 @@ func PrintStartupLog(monitororCli *cli.MonitororCli) error {
 	for name, config := range monitororCli.Store.CoreConfig.NamedConfigs {
 		monitororInfo.NamedConfigs = append(monitororInfo.NamedConfigs, namedConfigInfo{Name: string(name), Value: config})
 	}
+	if configDir := monitororCli.Store.CoreConfig.ConfigDir; configDir != "" {
+		for name, config := range coreConfig.NewNamedConfigDir(path.ToAbsolute(path.MonitororBaseDir, configDir)).NamedConfigs() {
+			if _, ok := monitororCli.Store.CoreConfig.NamedConfigs[name]; !ok {
+				monitororInfo.NamedConfigs = append(monitororInfo.NamedConfigs, namedConfigInfo{Name: string(name), Value: config})
+			}
+		}
+	}
 	monitororInfo.NamedConfigs = sortNamedConfigs(monitororInfo.NamedConfigs)
 
+	// Remote configs info
+	for name, remoteConfig := range monitororCli.Store.CoreConfig.RemoteConfigs {
+		if err := remoteConfig.Validate(); err != nil {
+			monitororInfo.ErroredRemoteConfigs = append(monitororInfo.ErroredRemoteConfigs, remoteConfigInfo{Name: name, Error: err})
+		}
+	}
+	sort.Slice(monitororInfo.ErroredRemoteConfigs, func(i, j int) bool {
+		return monitororInfo.ErroredRemoteConfigs[i].Name < monitororInfo.ErroredRemoteConfigs[j].Name
+	})
+
 	// Monitorables info
 	for _, mm := range monitororCli.Store.Registry.GetMonitorables() {
 		monitorableInfo := monitorableInfo{
@@ -295,6 +295,47 @@ AVAILABLE NAMED CONFIGURATIONS
   test2 -> test2
 
 
+─────────────────────────────────────────────────
+
+MONITOROR IS RUNNING AT:
+  http://1.2.3.4:3000
+
+─────────────────────────────────────────────────
+
+`
+
+	assert.NoError(t, PrintStartupLog(monitororCli))
+	assert.Equal(t, expected, output.String())
+}
+
+func TestPrintMonitororStartupLog_WithErroredRemoteConfigs(t *testing.T) {
+	output := &bytes.Buffer{}
+	monitororCli := initCli(output)
+	monitororCli.Store.CoreConfig.RemoteConfigs = map[string]*config.RemoteConfig{
+		config.DefaultRemoteConfigName: {Token: "xxx"},
+		"gitlab":                       {URLPrefix: "https://gitlab.example.com/", Token: "xxx"},
+	}
+
+	expected := `
+    __  ___            _ __
+   /  |/  /___  ____  (_) /_____  _________  _____
+  / /|_/ / __ \/ __ \/ / __/ __ \/ ___/ __ \/ ___/
+ / /  / / /_/ / / / / / /_/ /_/ / /  / /_/ / / ` + `
+/_/  /_/\____/_/ /_/_/\__/\____/_/   \____/_/  1.0.0
+
+https://monitoror.com
+
+
+ENABLED MONITORABLES
+
+
+
+ERRORED REMOTE CONFIGURATIONS
+
+  /!\ Errored "default" remote configuration
+    URL prefix is required with headers, token or login / password
+
+
 ─────────────────────────────────────────────────
 
 MONITOROR IS RUNNING AT:
@@ -36,6 +36,29 @@ type (
 		// InitialMaxDelay is used to add delay on first method to avoid bursting x requests in same time on start
 		InitialMaxDelay int // in Millisecond
 
+		// Min / Max bounds of refreshInterval and cacheExpiration set on tiles in UI config
+		MinTileRefreshInterval int // in Millisecond
+		MaxTileRefreshInterval int // in Millisecond
+		MinTileCacheExpiration int // in Millisecond
+		MaxTileCacheExpiration int // in Millisecond
+		// MaxTileHistorySize is the maximum number of past values kept by tile with history
+		MaxTileHistorySize int
+
+		// --- Config Configuration ---
+		// ConfigMaxSize is the maximum size of config sent to the API (ex: verify endpoint)
+		ConfigMaxSize int // in Kilobyte
+		// GeneratorTimeout is the maximum duration of tile generation during hydration. Previous generated tiles are used after that
+		GeneratorTimeout int // in Millisecond
+		// ConfigDir is a directory where each supported config file (ex: screen1.json) is loaded as named config (ex: screen1)
+		// Config declared with MO_CONFIG_<NAME> take precedence over config found in this directory
+		ConfigDir string
+		// ConfigStoreDir is the directory where configs written through the API are stored with their revisions
+		// Config storage (and PUT / DELETE on configs) is disabled when empty
+		ConfigStoreDir string
+		// ConfigStoreTokens are the Bearer tokens allowed to write configs through the API, like: "alice:xxx,ci:yyy"
+		// The name before ":" is recorded as author of revisions. Config writes are disabled when empty
+		ConfigStoreTokens string
+
 		// NamedConfig can contains ui config (path or url)
 		// Can contains default or named config file
 		// Like:
@@ -45,6 +68,13 @@ type (
 		//
 		// Note: it's the only way to load config file outside of monitoror directory
 		NamedConfigs map[ConfigName]string
+
+		// RemoteConfigs contains settings used to download named config from URL (auth, TLS, timeout)
+		// Like:
+		//		MO_REMOTECONFIG_SSLVERIFY=false
+		//		MO_REMOTECONFIG_GITLAB_URLPREFIX=https://gitlab.example.com/
+		//		MO_REMOTECONFIG_GITLAB_HEADERS="Private-Token: xxx"
+		RemoteConfigs map[string]*RemoteConfig
 	}
 
 	//nolint:golint
@@ -58,6 +88,13 @@ var defaultConfig = &CoreConfig{
 	UpstreamCacheExpiration:   10000,
 	DownstreamCacheExpiration: 120000,
 	InitialMaxDelay:           1700,
+	MinTileRefreshInterval:    1000,
+	MaxTileRefreshInterval:    3600000,
+	MinTileCacheExpiration:    1000,
+	MaxTileCacheExpiration:    3600000,
+	MaxTileHistorySize:        100,
+	ConfigMaxSize:             1024,
+	GeneratorTimeout:          10000,
 }
 
 // InitConfig from configuration file / env / default value
@@ -79,10 +116,25 @@ func InitConfig() *CoreConfig {
 
 	// Setup NamedConfig without viper
 	loadNamedConfig(coreConfig)
+	loadRemoteConfigs(coreConfig)
 
 	return coreConfig
 }
 
+// GetConfigStoreTokens return authors of ConfigStoreTokens by token. Malformed entries are ignored
+func (c *CoreConfig) GetConfigStoreTokens() map[string]string {
+	tokens := make(map[string]string)
+	for _, entry := range strings.Split(c.ConfigStoreTokens, ",") {
+		splittedEntry := strings.SplitN(strings.TrimSpace(entry), ":", 2)
+		if len(splittedEntry) != 2 || splittedEntry[0] == "" || splittedEntry[1] == "" {
+			continue
+		}
+		tokens[splittedEntry[1]] = splittedEntry[0]
+	}
+
+	return tokens
+}
+
 // loadUiConfig load NamedConfig
 // Note: it's to "hacky" and complicated with viper so i do it manually
 func loadNamedConfig(config *CoreConfig) {
@@ -93,7 +145,8 @@ func loadNamedConfig(config *CoreConfig) {
 	env.InitEnvDefaultLabel(envPrefix, "", string(DefaultConfigName))
 
 	for _, env := range os.Environ() {
-		if strings.HasPrefix(env, envPrefix) {
+		// Skip core config fields sharing the same prefix (ex: MO_CONFIGMAXSIZE)
+		if strings.HasPrefix(env, envPrefix+"_") {
 			splittedEnv := strings.Split(env, "=")
 
 			configName := strings.TrimPrefix(splittedEnv[0], envPrefix)
@@ -16,6 +16,8 @@ func TestInitConfig_WithEnv(t *testing.T) {
 	assert.NoError(t, os.Setenv(EnvPrefix+"_PORT", "3000"))
 	assert.NoError(t, os.Setenv(EnvPrefix+"_CONFIG", "default"))
 	assert.NoError(t, os.Setenv(EnvPrefix+"_CONFIG_SCREEN1", "1"))
+	assert.NoError(t, os.Setenv(EnvPrefix+"_GENERATORTIMEOUT", "5000"))
+	assert.NoError(t, os.Setenv(EnvPrefix+"_CONFIGDIR", "./configs"))
 
 	config := InitConfig()
 
@@ -23,4 +25,38 @@ func TestInitConfig_WithEnv(t *testing.T) {
 	assert.Equal(t, 3000, config.Port)
 	assert.Equal(t, "default", config.NamedConfigs["default"])
 	assert.Equal(t, "1", config.NamedConfigs["screen1"])
+	assert.Equal(t, 5000, config.GeneratorTimeout)
+	assert.Equal(t, "./configs", config.ConfigDir)
+	assert.Len(t, config.NamedConfigs, 2)
+}
+
+func TestInitConfig_WithRemoteConfigs(t *testing.T) {
+	assert.NoError(t, os.Setenv(EnvPrefix+"_REMOTECONFIG_TIMEOUT", "3000"))
+	assert.NoError(t, os.Setenv(EnvPrefix+"_REMOTECONFIG_GITLAB_URLPREFIX", "https://gitlab.example.com/"))
+	assert.NoError(t, os.Setenv(EnvPrefix+"_REMOTECONFIG_GITLAB_TOKEN", "xxx"))
+	defer func() {
+		_ = os.Unsetenv(EnvPrefix + "_REMOTECONFIG_TIMEOUT")
+		_ = os.Unsetenv(EnvPrefix + "_REMOTECONFIG_DEFAULT_TIMEOUT")
+		_ = os.Unsetenv(EnvPrefix + "_REMOTECONFIG_GITLAB_URLPREFIX")
+		_ = os.Unsetenv(EnvPrefix + "_REMOTECONFIG_GITLAB_TOKEN")
+	}()
+
+	config := InitConfig()
+
+	if assert.Len(t, config.RemoteConfigs, 2) {
+		assert.Equal(t, 3000, config.RemoteConfigs[DefaultRemoteConfigName].Timeout)
+		assert.Equal(t, "", config.RemoteConfigs[DefaultRemoteConfigName].URLPrefix)
+		assert.True(t, config.RemoteConfigs[DefaultRemoteConfigName].SSLVerify)
+		assert.Equal(t, "https://gitlab.example.com/", config.RemoteConfigs["gitlab"].URLPrefix)
+		assert.Equal(t, "xxx", config.RemoteConfigs["gitlab"].Token)
+		assert.Equal(t, DefaultRemoteConfig.Timeout, config.RemoteConfigs["gitlab"].Timeout)
+	}
+}
+
+func TestCoreConfig_GetConfigStoreTokens(t *testing.T) {
+	config := &CoreConfig{}
+	assert.Empty(t, config.GetConfigStoreTokens())
+
+	config.ConfigStoreTokens = "alice:s3cr3t, ci:t0k:en,invalid,:empty,empty:"
+	assert.Equal(t, map[string]string{"s3cr3t": "alice", "t0k:en": "ci"}, config.GetConfigStoreTokens())
 }
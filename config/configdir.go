@@ -0,0 +1,77 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SupportedConfigExtensions list file extensions loaded as named config from ConfigDir
+var SupportedConfigExtensions = []string{".json"}
+
+type (
+	// NamedConfigDir discover named configs in a directory
+	// Directory is scanned again only when its content changed (file added, removed or renamed)
+	NamedConfigDir struct {
+		path string
+
+		mutex        sync.Mutex
+		modTime      time.Time
+		namedConfigs map[ConfigName]string
+	}
+)
+
+func NewNamedConfigDir(path string) *NamedConfigDir {
+	return &NamedConfigDir{path: path}
+}
+
+// NamedConfigs return config files of directory keyed by their lowercase name without extension
+func (cd *NamedConfigDir) NamedConfigs() map[ConfigName]string {
+	cd.mutex.Lock()
+	defer cd.mutex.Unlock()
+
+	dirInfo, err := os.Stat(cd.path)
+	if err != nil {
+		cd.modTime = time.Time{}
+		cd.namedConfigs = nil
+		return nil
+	}
+
+	if cd.namedConfigs == nil || !dirInfo.ModTime().Equal(cd.modTime) {
+		cd.modTime = dirInfo.ModTime()
+		cd.namedConfigs = scanNamedConfigDir(cd.path)
+	}
+
+	return cd.namedConfigs
+}
+
+func scanNamedConfigDir(path string) map[ConfigName]string {
+	namedConfigs := make(map[ConfigName]string)
+
+	files, err := ioutil.ReadDir(path)
+	if err != nil {
+		return namedConfigs
+	}
+
+	for _, file := range files {
+		if file.IsDir() || strings.HasPrefix(file.Name(), ".") {
+			continue
+		}
+
+		extension := filepath.Ext(file.Name())
+		for _, supportedExtension := range SupportedConfigExtensions {
+			if strings.EqualFold(extension, supportedExtension) {
+				configName := ConfigName(strings.ToLower(strings.TrimSuffix(file.Name(), extension)))
+				if _, exists := namedConfigs[configName]; !exists {
+					namedConfigs[configName] = filepath.Join(path, file.Name())
+				}
+				break
+			}
+		}
+	}
+
+	return namedConfigs
+}
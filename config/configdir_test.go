@@ -0,0 +1,44 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamedConfigDir(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "test-named-config-dir-")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	for _, file := range []string{"default.json", "Screen1.JSON", "readme.md", ".hidden.json"} {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, file), []byte("{}"), 0644))
+	}
+	assert.NoError(t, os.Mkdir(filepath.Join(dir, "subdir.json"), 0755))
+
+	configDir := NewNamedConfigDir(dir)
+	assert.Equal(t, map[ConfigName]string{
+		"default": filepath.Join(dir, "default.json"),
+		"screen1": filepath.Join(dir, "Screen1.JSON"),
+	}, configDir.NamedConfigs())
+
+	// Rescan after change
+	assert.NoError(t, os.Remove(filepath.Join(dir, "default.json")))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "screen2.json"), []byte("{}"), 0644))
+	future := time.Now().Add(time.Minute) // Force mod time change on file system with low resolution
+	assert.NoError(t, os.Chtimes(dir, future, future))
+
+	assert.Equal(t, map[ConfigName]string{
+		"screen1": filepath.Join(dir, "Screen1.JSON"),
+		"screen2": filepath.Join(dir, "screen2.json"),
+	}, configDir.NamedConfigs())
+
+	// Missing directory
+	assert.Nil(t, NewNamedConfigDir(filepath.Join(dir, "missing")).NamedConfigs())
+}
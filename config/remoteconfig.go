@@ -0,0 +1,50 @@
+package config
+
+import (
+	"errors"
+
+	pkgConfig "github.com/monitoror/monitoror/internal/pkg/monitorable/config"
+	"github.com/monitoror/monitoror/models"
+)
+
+const DefaultRemoteConfigName = "default"
+
+type (
+	// RemoteConfig contains settings used to download configs from URL
+	// Each RemoteConfig apply to URLs starting with URLPrefix. The longest matching prefix is used.
+	RemoteConfig struct {
+		// URLPrefix of URLs using this settings, matched on scheme, host and path boundaries
+		// Empty prefix match every URL, but Headers, Token and Login / Password are only sent to an explicit prefix (see Validate)
+		URLPrefix string
+		// Headers added to requests, like: "Private-Token: xxx; X-Custom: yyy"
+		Headers string
+		// Token used as Bearer token
+		Token string
+		// Login / Password used for basic auth when Token is empty
+		Login    string
+		Password string
+		// CACert is the path of a PEM bundle trusted in addition to system certificates
+		CACert    string
+		SSLVerify bool
+		Timeout   int `validate:"gte=0"` // In Millisecond
+	}
+)
+
+var DefaultRemoteConfig = &RemoteConfig{
+	SSLVerify: true,
+	Timeout:   10000,
+}
+
+// loadRemoteConfigs load RemoteConfigs from env. Default remote config always exists
+func loadRemoteConfigs(config *CoreConfig) {
+	config.RemoteConfigs = make(map[string]*RemoteConfig)
+	pkgConfig.LoadConfigWithVariant(EnvPrefix, models.VariantName(DefaultRemoteConfigName), &config.RemoteConfigs, DefaultRemoteConfig)
+}
+
+// Validate check that credentials are set with an URLPrefix, they are never sent without it
+func (rc *RemoteConfig) Validate() error {
+	if rc.URLPrefix == "" && (rc.Headers != "" || rc.Token != "" || rc.Login != "" || rc.Password != "") {
+		return errors.New("URL prefix is required with headers, token or login / password")
+	}
+	return nil
+}
@@ -5,6 +5,12 @@ go 1.14
 require (
 	github.com/AlekSi/pointer v1.0.0
 	github.com/GeertJohan/go.rice v1.0.0
+	github.com/PaesslerAG/gval v1.0.0
+	github.com/PaesslerAG/jsonpath v0.1.1
+	github.com/PuerkitoBio/goquery v1.6.1
+	github.com/andybalholm/cascadia v1.1.0
+	github.com/antchfx/xmlquery v1.3.5
+	github.com/antchfx/xpath v1.1.10
 	github.com/basgys/goxml2json v1.1.0
 	github.com/bitly/go-simplejson v0.5.0 // indirect
 	github.com/bmizerany/assert v0.0.0-20160611221934-b7ed37b82869 // indirect
@@ -15,6 +21,7 @@ require (
 	github.com/go-playground/universal-translator v0.17.0 // indirect
 	github.com/go-playground/validator v9.31.0+incompatible
 	github.com/google/go-github v17.0.0+incompatible
+	github.com/jmespath/go-jmespath v0.4.0
 	github.com/joho/godotenv v1.3.0
 	github.com/jsdidierlaurent/azure-devops-go-api/azuredevops v0.0.0-20191016103718-deea5b1446b8
 	github.com/jsdidierlaurent/echo-middleware v1.0.3
@@ -24,6 +31,7 @@ require (
 	github.com/labstack/gommon v0.2.9
 	github.com/leodido/go-urn v1.2.0 // indirect
 	github.com/orcaman/concurrent-map v0.0.0-20190314100340-2693aad1ed75
+	github.com/robfig/cron/v3 v3.0.1
 	github.com/satori/go.uuid v1.2.0
 	github.com/shuheiktgw/go-travis v0.2.2
 	github.com/sourcegraph/httpcache v0.0.0-20160524185540-16db777d8ebe
@@ -32,10 +40,8 @@ require (
 	github.com/spf13/viper v1.4.0
 	github.com/stretchr/testify v1.4.0
 	github.com/xanzy/go-gitlab v0.31.0
-	golang.org/x/crypto v0.0.0-20190701094942-4def268fd1a4 // indirect
-	golang.org/x/net v0.0.0-20191209160850-c0dbc17a3553
+	golang.org/x/net v0.0.0-20200813134508-3edf25e44fcc
 	golang.org/x/oauth2 v0.0.0-20181106182150-f42d05182288
-	golang.org/x/sys v0.0.0-20190801041406-cbf593c0f2f3 // indirect
 	gopkg.in/go-playground/assert.v1 v1.2.1 // indirect
-	gopkg.in/yaml.v2 v2.2.2
+	gopkg.in/yaml.v2 v2.2.8
 )
@@ -9,6 +9,11 @@ type (
 		Validate() []validator.Error
 	}
 
+	// Factory is implemented by Validator which can't be instantiated by reflection (ex: params with dynamic schema)
+	Factory interface {
+		NewValidator() Validator
+	}
+
 	Default struct{}
 )
 
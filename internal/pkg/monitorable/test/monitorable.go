@@ -31,6 +31,7 @@ type (
 func InitMockAndStore() (*store.Store, MockMonitorableHelper) {
 	mockRouterGroup := new(serviceMock.MonitorableRouterGroup)
 	mockRouterGroup.On("GET", mock.AnythingOfType("string"), mock.AnythingOfType("echo.HandlerFunc"), mock.Anything).Return(&echo.Route{Path: "/path"})
+	mockRouterGroup.On("POST", mock.AnythingOfType("string"), mock.AnythingOfType("echo.HandlerFunc"), mock.Anything).Return(&echo.Route{Path: "/path"})
 
 	mockRouter := new(serviceMock.MonitorableRouter)
 	mockRouter.On("Group", mock.AnythingOfType("string"), mock.AnythingOfType("models.VariantName")).Return(mockRouterGroup)
@@ -59,6 +60,7 @@ func InitMockAndStore() (*store.Store, MockMonitorableHelper) {
 		mock.AnythingOfType("versions.RawVersion"),
 		mock.AnythingOfType("[]models.VariantName"),
 	).Return(mockGeneratorEnabler)
+	mockRegistry.On("IsTileRegistered", mock.AnythingOfType("models.TileType")).Return(false)
 
 	return &store.Store{
 			CoreConfig:        &coreConfig.CoreConfig{},
@@ -6,4 +6,7 @@ const (
 	DownstreamCacheHeader     = "Timeout-Recover"
 
 	UpstreamStoreKeyPrefix = "monitoror.upstream.key"
+
+	// CacheExpirationQueryParam is added to tile URL to override upstream cache expiration (in Millisecond)
+	CacheExpirationQueryParam = "cacheExpiration"
 )
@@ -0,0 +1,9 @@
+package models
+
+const (
+	HistoryStoreKeyPrefix = "monitoror.history.key"
+
+	// HistorySizeQueryParam / HistoryIntervalQueryParam are added to tile URL to keep past values of tile (interval in Millisecond)
+	HistorySizeQueryParam     = "historySize"
+	HistoryIntervalQueryParam = "historyInterval"
+)
@@ -0,0 +1,7 @@
+package models
+
+const (
+	// WarningThresholdQueryParam / FailureThresholdQueryParam are added to tile URL to change status of tile according its value
+	WarningThresholdQueryParam = "warningThreshold"
+	FailureThresholdQueryParam = "failureThreshold"
+)
@@ -4,16 +4,32 @@ type (
 	TileValue struct {
 		Values []string       `json:"values"`
 		Unit   TileValuesUnit `json:"unit"`
+
+		// Timestamps in Millisecond of each value. Only set when tile keep history of its values
+		Timestamps []int64 `json:"timestamps,omitempty"`
+
+		// Formatting options used by the UI
+		Precision *int   `json:"precision,omitempty"` // Number of decimals
+		Prefix    string `json:"prefix,omitempty"`
+		Suffix    string `json:"suffix,omitempty"`
+		Currency  string `json:"currency,omitempty"` // ISO 4217 code like EUR or USD, used with CurrencyUnit
 	}
 
 	TileValuesUnit string
 )
 
 const (
-	MillisecondUnit TileValuesUnit = "MILLISECOND" // Duration in ms
-	RatioUnit       TileValuesUnit = "RATIO"       // Ratio like 0.8465896
-	NumberUnit      TileValuesUnit = "NUMBER"      // Number in float
-	RawUnit         TileValuesUnit = "RAW"         // String
+	MillisecondUnit TileValuesUnit = "MILLISECOND"  // Duration in ms
+	SecondUnit      TileValuesUnit = "SECOND"       // Duration in s
+	RatioUnit       TileValuesUnit = "RATIO"        // Ratio like 0.8465896
+	PercentUnit     TileValuesUnit = "PERCENT"      // Percentage like 84.65896
+	NumberUnit      TileValuesUnit = "NUMBER"       // Number in float
+	BytesUnit       TileValuesUnit = "BYTES"        // Size in bytes, scaled with decimal prefix (kB, MB, GB, ...)
+	BinaryBytesUnit TileValuesUnit = "BINARY_BYTES" // Size in bytes, scaled with binary prefix (KiB, MiB, GiB, ...)
+	CurrencyUnit    TileValuesUnit = "CURRENCY"     // Amount of money in Currency
+	PerSecondUnit   TileValuesUnit = "PER_SECOND"   // Rate by second
+	PerMinuteUnit   TileValuesUnit = "PER_MINUTE"   // Rate by minute
+	RawUnit         TileValuesUnit = "RAW"          // String
 )
 
 func (t *Tile) WithValue(unit TileValuesUnit) *Tile {
@@ -0,0 +1,34 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/delivery"
+	"github.com/monitoror/monitoror/monitorables/dns/api"
+	"github.com/monitoror/monitoror/monitorables/dns/api/models"
+
+	"github.com/labstack/echo/v4"
+)
+
+type DNSDelivery struct {
+	dnsUsecase api.Usecase
+}
+
+func NewDNSDelivery(u api.Usecase) *DNSDelivery {
+	return &DNSDelivery{u}
+}
+
+func (h *DNSDelivery) GetDNS(c echo.Context) error {
+	// Bind / check Params
+	params := &models.DNSParams{}
+	if err := delivery.BindAndValidateParams(c, params); err != nil {
+		return err
+	}
+
+	tile, err := h.dnsUsecase.DNS(params)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, tile)
+}
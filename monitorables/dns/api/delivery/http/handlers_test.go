@@ -0,0 +1,83 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/dns/api"
+	"github.com/monitoror/monitoror/monitorables/dns/api/mocks"
+	"github.com/monitoror/monitoror/monitorables/dns/api/models"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	. "github.com/stretchr/testify/mock"
+)
+
+func initEcho() (ctx echo.Context, res *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(echo.GET, "/api/v1/info", nil)
+	res = httptest.NewRecorder()
+	ctx = e.NewContext(req, res)
+
+	ctx.QueryParams().Set("hostname", "monitoror.example.com")
+	ctx.QueryParams().Set("recordType", "MX")
+
+	return
+}
+
+func TestDelivery_DNSHandler_Success(t *testing.T) {
+	// Init
+	ctx, res := initEcho()
+
+	tile := coreModels.NewTile(api.DNSTileType)
+	tile.Label = "monitoror.example.com (MX)"
+	tile.Status = coreModels.SuccessStatus
+
+	mockUsecase := new(mocks.Usecase)
+	mockUsecase.On("DNS", &models.DNSParams{Hostname: "monitoror.example.com", RecordType: models.MXRecordType}).Return(tile, nil)
+	handler := NewDNSDelivery(mockUsecase)
+
+	// Expected
+	json, err := json.Marshal(tile)
+	assert.NoError(t, err, "unable to marshal tile")
+
+	// Test
+	if assert.NoError(t, handler.GetDNS(ctx)) {
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(json), strings.TrimSpace(res.Body.String()))
+		mockUsecase.AssertNumberOfCalls(t, "DNS", 1)
+		mockUsecase.AssertExpectations(t)
+	}
+}
+
+func TestDelivery_DNSHandler_QueryParamsError_MissingHostname(t *testing.T) {
+	// Init
+	ctx, _ := initEcho()
+	ctx.QueryParams().Del("hostname")
+	mockUsecase := new(mocks.Usecase)
+	handler := NewDNSDelivery(mockUsecase)
+
+	// Test
+	err := handler.GetDNS(ctx)
+	assert.Error(t, err)
+	assert.IsType(t, &coreModels.MonitororError{}, err)
+}
+
+func TestDelivery_DNSHandler_Error(t *testing.T) {
+	// Init
+	ctx, _ := initEcho()
+
+	mockUsecase := new(mocks.Usecase)
+	mockUsecase.On("DNS", Anything).Return(nil, errors.New("dns error"))
+	handler := NewDNSDelivery(mockUsecase)
+
+	// Test
+	assert.Error(t, handler.GetDNS(ctx))
+	mockUsecase.AssertNumberOfCalls(t, "DNS", 1)
+	mockUsecase.AssertExpectations(t)
+}
@@ -0,0 +1,55 @@
+package models
+
+import (
+	"regexp"
+	"time"
+)
+
+type (
+	// Resolution is the result of a DNS query
+	Resolution struct {
+		Records []string
+		Latency time.Duration
+	}
+
+	// RecordType is the type of DNS records to resolve, records are formatted like this:
+	// - A, AAAA: "93.184.216.34"
+	// - CNAME, NS: "example.com"
+	// - MX: "10 mail.example.com" (preference host)
+	// - TXT: "v=spf1 -all"
+	// - SRV: "10 5 5060 sip.example.com" (priority weight port target)
+	RecordType string
+)
+
+const (
+	ARecordType     RecordType = "A"
+	AAAARecordType  RecordType = "AAAA"
+	CNAMERecordType RecordType = "CNAME"
+	MXRecordType    RecordType = "MX"
+	TXTRecordType   RecordType = "TXT"
+	SRVRecordType   RecordType = "SRV"
+	NSRecordType    RecordType = "NS"
+)
+
+const DefaultWarningLatency = 500 // In Millisecond
+
+func getRecordTypeWithDefault(recordType RecordType) RecordType {
+	if recordType == "" {
+		return ARecordType
+	}
+	return recordType
+}
+
+func getWarningLatencyWithDefault(latency *int) time.Duration {
+	if latency == nil {
+		return time.Millisecond * DefaultWarningLatency
+	}
+	return time.Millisecond * time.Duration(*latency)
+}
+
+func getRegex(regex string) *regexp.Regexp {
+	if regex == "" {
+		return nil
+	}
+	return regexp.MustCompile(regex) // Already validate by validator
+}
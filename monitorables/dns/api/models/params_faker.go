@@ -0,0 +1,37 @@
+//+build faker
+
+package models
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/params"
+	coreModels "github.com/monitoror/monitoror/models"
+)
+
+type (
+	DNSParams struct {
+		params.Default
+
+		Hostname   string     `json:"hostname" query:"hostname" validate:"required"`
+		RecordType RecordType `json:"recordType,omitempty" query:"recordType" validate:"omitempty,oneof=A AAAA CNAME MX TXT SRV NS"`
+
+		// Expected records, in any order
+		Expected []string `json:"expected,omitempty" query:"expected"`
+		// Regex that each record must match
+		Regex string `json:"regex,omitempty" query:"regex" validate:"regex"`
+
+		WarningLatency *int `json:"warningLatency,omitempty" query:"warningLatency" validate:"omitempty,gt=0"` // In Millisecond
+
+		Status      coreModels.TileStatus `json:"status" query:"status"`
+		Message     string                `json:"message" query:"message"`
+		ValueValues []string              `json:"valueValues" query:"valueValues"`
+	}
+)
+
+func (p *DNSParams) GetRecordType() RecordType { return getRecordTypeWithDefault(p.RecordType) }
+func (p *DNSParams) GetRegex() *regexp.Regexp  { return getRegex(p.Regex) }
+func (p *DNSParams) GetWarningLatency() time.Duration {
+	return getWarningLatencyWithDefault(p.WarningLatency)
+}
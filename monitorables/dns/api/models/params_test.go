@@ -0,0 +1,43 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/test"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDNSParams_Validate(t *testing.T) {
+	param := &DNSParams{}
+	test.AssertParams(t, param, 1)
+
+	param = &DNSParams{Hostname: "example.com", RecordType: "PTR"}
+	test.AssertParams(t, param, 1)
+
+	param = &DNSParams{Hostname: "example.com", Regex: "("}
+	test.AssertParams(t, param, 1)
+
+	param = &DNSParams{Hostname: "example.com", WarningLatency: pointer.ToInt(0)}
+	test.AssertParams(t, param, 1)
+
+	param = &DNSParams{Hostname: "example.com"}
+	test.AssertParams(t, param, 0)
+
+	param = &DNSParams{Hostname: "example.com", RecordType: MXRecordType, Expected: []string{"10 mail.example.com"}, Regex: "example", WarningLatency: pointer.ToInt(100)}
+	test.AssertParams(t, param, 0)
+}
+
+func TestDNSParams_Getters(t *testing.T) {
+	param := &DNSParams{Hostname: "example.com"}
+	assert.Equal(t, ARecordType, param.GetRecordType())
+	assert.Nil(t, param.GetRegex())
+	assert.Equal(t, DefaultWarningLatency*time.Millisecond, param.GetWarningLatency())
+
+	param = &DNSParams{Hostname: "example.com", RecordType: NSRecordType, Regex: "^ns[0-9]\\.", WarningLatency: pointer.ToInt(100)}
+	assert.Equal(t, NSRecordType, param.GetRecordType())
+	assert.True(t, param.GetRegex().MatchString("ns1.example.com"))
+	assert.Equal(t, 100*time.Millisecond, param.GetWarningLatency())
+}
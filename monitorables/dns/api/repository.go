@@ -0,0 +1,14 @@
+//go:generate mockery -name Repository
+
+package api
+
+import (
+	"github.com/monitoror/monitoror/monitorables/dns/api/models"
+)
+
+type (
+	Repository interface {
+		// Resolve return records of hostname formatted as strings (see models.RecordType) and resolution latency
+		Resolve(hostname string, recordType models.RecordType) (*models.Resolution, error)
+	}
+)
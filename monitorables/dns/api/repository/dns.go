@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/monitoror/monitoror/monitorables/dns/api"
+	"github.com/monitoror/monitoror/monitorables/dns/api/models"
+	"github.com/monitoror/monitoror/monitorables/dns/config"
+)
+
+type (
+	dnsRepository struct {
+		resolver *net.Resolver
+		timeout  time.Duration
+	}
+)
+
+func NewDNSRepository(conf *config.DNS) api.Repository {
+	resolver := net.DefaultResolver
+
+	address, _ := conf.GetResolverAddress() // Already validate by Monitorable.Validate
+	if address != "" {
+		resolver = &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				var dialer net.Dialer
+				return dialer.DialContext(ctx, network, address)
+			},
+		}
+	}
+
+	return &dnsRepository{resolver, time.Millisecond * time.Duration(conf.Timeout)}
+}
+
+func (r *dnsRepository) Resolve(hostname string, recordType models.RecordType) (*models.Resolution, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	start := time.Now()
+	records, err := r.lookup(ctx, hostname, recordType)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Resolution{Records: records, Latency: time.Since(start)}, nil
+}
+
+func (r *dnsRepository) lookup(ctx context.Context, hostname string, recordType models.RecordType) (records []string, err error) {
+	switch recordType {
+	case models.ARecordType, models.AAAARecordType:
+		network := "ip4"
+		if recordType == models.AAAARecordType {
+			network = "ip6"
+		}
+
+		var ips []net.IP
+		if ips, err = r.resolver.LookupIP(ctx, network, hostname); err == nil {
+			for _, ip := range ips {
+				records = append(records, ip.String())
+			}
+		}
+	case models.CNAMERecordType:
+		var cname string
+		if cname, err = r.resolver.LookupCNAME(ctx, hostname); err == nil {
+			records = append(records, normalizeName(cname))
+		}
+	case models.MXRecordType:
+		var mxs []*net.MX
+		if mxs, err = r.resolver.LookupMX(ctx, hostname); err == nil {
+			for _, mx := range mxs {
+				records = append(records, fmt.Sprintf("%d %s", mx.Pref, normalizeName(mx.Host)))
+			}
+		}
+	case models.TXTRecordType:
+		records, err = r.resolver.LookupTXT(ctx, hostname)
+	case models.SRVRecordType:
+		var srvs []*net.SRV
+		if _, srvs, err = r.resolver.LookupSRV(ctx, "", "", hostname); err == nil {
+			for _, srv := range srvs {
+				records = append(records, fmt.Sprintf("%d %d %d %s", srv.Priority, srv.Weight, srv.Port, normalizeName(srv.Target)))
+			}
+		}
+	case models.NSRecordType:
+		var nss []*net.NS
+		if nss, err = r.resolver.LookupNS(ctx, hostname); err == nil {
+			for _, ns := range nss {
+				records = append(records, normalizeName(ns.Host))
+			}
+		}
+	default:
+		err = fmt.Errorf("unsupported record type %q", recordType)
+	}
+
+	return
+}
+
+// normalizeName remove trailing dot of fully qualified domain name
+func normalizeName(name string) string {
+	return strings.TrimSuffix(strings.ToLower(name), ".")
+}
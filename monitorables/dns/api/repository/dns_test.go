@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/monitoror/monitoror/monitorables/dns/api/models"
+	"github.com/monitoror/monitoror/monitorables/dns/config"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// zone of the local DNS stand-in, names are fully qualified
+var zone = map[string][]dnsmessage.ResourceBody{
+	"example.com.": {
+		&dnsmessage.AResource{A: [4]byte{93, 184, 216, 34}},
+		&dnsmessage.AAAAResource{AAAA: [16]byte{0x26, 0x06, 0x28, 0x00, 0x02, 0x20, 0, 0x01, 0x02, 0x48, 0x18, 0x93, 0x25, 0xc8, 0x19, 0x46}},
+		&dnsmessage.MXResource{Pref: 10, MX: dnsmessage.MustNewName("mail.example.com.")},
+		&dnsmessage.TXTResource{TXT: []string{"v=spf1 -all"}},
+		&dnsmessage.NSResource{NS: dnsmessage.MustNewName("A.IANA-SERVERS.NET.")},
+	},
+	"www.example.com.": {
+		&dnsmessage.CNAMEResource{CNAME: dnsmessage.MustNewName("example.com.")},
+	},
+	"_sip._udp.example.com.": {
+		&dnsmessage.SRVResource{Priority: 10, Weight: 5, Port: 5060, Target: dnsmessage.MustNewName("sip.example.com.")},
+	},
+}
+
+// serveDNS start a local DNS stand-in answering with zone records
+// unknown names are answered with NXDOMAIN, "broken.example.com." with SERVFAIL and "slow.example.com." never
+func serveDNS(t *testing.T) string {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	go func() {
+		buffer := make([]byte, 512)
+		for {
+			n, addr, err := conn.ReadFrom(buffer)
+			if err != nil {
+				return
+			}
+
+			var request dnsmessage.Message
+			if err := request.Unpack(buffer[:n]); err != nil || len(request.Questions) != 1 {
+				continue
+			}
+			question := request.Questions[0]
+			name := strings.ToLower(question.Name.String())
+			if name == "slow.example.com." {
+				continue
+			}
+
+			response := dnsmessage.Message{
+				Header:    dnsmessage.Header{ID: request.ID, Response: true, Authoritative: true, RecursionAvailable: true},
+				Questions: request.Questions,
+			}
+
+			records, ok := zone[name]
+			switch {
+			case name == "broken.example.com.":
+				response.RCode = dnsmessage.RCodeServerFailure
+			case !ok:
+				response.RCode = dnsmessage.RCodeNameError
+			default:
+				response.Answers = answer(question, records)
+			}
+
+			packed, _ := response.Pack()
+			_, _ = conn.WriteTo(packed, addr)
+		}
+	}()
+
+	return conn.LocalAddr().String()
+}
+
+// answer return records matching question type, following CNAME
+func answer(question dnsmessage.Question, records []dnsmessage.ResourceBody) (answers []dnsmessage.Resource) {
+	for _, record := range records {
+		header := dnsmessage.ResourceHeader{Name: question.Name, Class: dnsmessage.ClassINET, TTL: 60}
+		switch body := record.(type) {
+		case *dnsmessage.CNAMEResource:
+			answers = append(answers, dnsmessage.Resource{Header: header, Body: body})
+			if question.Type != dnsmessage.TypeCNAME {
+				target := dnsmessage.Question{Name: body.CNAME, Type: question.Type, Class: question.Class}
+				answers = append(answers, answer(target, zone[strings.ToLower(body.CNAME.String())])...)
+			}
+		default:
+			if typeOf(record) == question.Type {
+				answers = append(answers, dnsmessage.Resource{Header: header, Body: body})
+			}
+		}
+	}
+
+	return answers
+}
+
+func typeOf(record dnsmessage.ResourceBody) dnsmessage.Type {
+	switch record.(type) {
+	case *dnsmessage.AResource:
+		return dnsmessage.TypeA
+	case *dnsmessage.AAAAResource:
+		return dnsmessage.TypeAAAA
+	case *dnsmessage.MXResource:
+		return dnsmessage.TypeMX
+	case *dnsmessage.TXTResource:
+		return dnsmessage.TypeTXT
+	case *dnsmessage.NSResource:
+		return dnsmessage.TypeNS
+	case *dnsmessage.SRVResource:
+		return dnsmessage.TypeSRV
+	}
+	return 0
+}
+
+func TestRepository_Resolve(t *testing.T) {
+	repository := NewDNSRepository(&config.DNS{Resolver: serveDNS(t), Timeout: 2000})
+
+	for _, testcase := range []struct {
+		hostname   string
+		recordType models.RecordType
+		expected   []string
+	}{
+		{hostname: "example.com", recordType: models.ARecordType, expected: []string{"93.184.216.34"}},
+		{hostname: "example.com", recordType: models.AAAARecordType, expected: []string{"2606:2800:220:1:248:1893:25c8:1946"}},
+		{hostname: "www.example.com", recordType: models.ARecordType, expected: []string{"93.184.216.34"}},
+		{hostname: "www.example.com", recordType: models.CNAMERecordType, expected: []string{"example.com"}},
+		{hostname: "example.com", recordType: models.MXRecordType, expected: []string{"10 mail.example.com"}},
+		{hostname: "example.com", recordType: models.TXTRecordType, expected: []string{"v=spf1 -all"}},
+		{hostname: "_sip._udp.example.com", recordType: models.SRVRecordType, expected: []string{"10 5 5060 sip.example.com"}},
+		{hostname: "example.com", recordType: models.NSRecordType, expected: []string{"a.iana-servers.net"}},
+	} {
+		resolution, err := repository.Resolve(testcase.hostname, testcase.recordType)
+		if assert.NoError(t, err, "%s %s", testcase.recordType, testcase.hostname) {
+			assert.Equal(t, testcase.expected, resolution.Records, "%s %s", testcase.recordType, testcase.hostname)
+			assert.True(t, resolution.Latency > 0)
+		}
+	}
+}
+
+func TestRepository_Resolve_Error(t *testing.T) {
+	repository := NewDNSRepository(&config.DNS{Resolver: serveDNS(t), Timeout: 500})
+
+	// NXDOMAIN
+	_, err := repository.Resolve("missing.example.com", models.ARecordType)
+	if dnsError, ok := err.(*net.DNSError); assert.True(t, ok) {
+		assert.True(t, dnsError.IsNotFound)
+	}
+
+	// SERVFAIL
+	_, err = repository.Resolve("broken.example.com", models.ARecordType)
+	if dnsError, ok := err.(*net.DNSError); assert.True(t, ok) {
+		assert.False(t, dnsError.IsNotFound)
+		assert.False(t, dnsError.Timeout())
+	}
+
+	// No answer
+	_, err = repository.Resolve("slow.example.com", models.ARecordType)
+	if dnsError, ok := err.(*net.DNSError); assert.True(t, ok) {
+		assert.True(t, dnsError.Timeout())
+	}
+}
@@ -0,0 +1,114 @@
+//+build !faker
+
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/dns/api"
+	"github.com/monitoror/monitoror/monitorables/dns/api/models"
+)
+
+type (
+	dnsUsecase struct {
+		repository api.Repository
+	}
+)
+
+func NewDNSUsecase(repository api.Repository) api.Usecase {
+	return &dnsUsecase{repository}
+}
+
+func (du *dnsUsecase) DNS(params *models.DNSParams) (*coreModels.Tile, error) {
+	tile := coreModels.NewTile(api.DNSTileType)
+	tile.Label = getLabel(params)
+
+	resolution, err := du.repository.Resolve(params.Hostname, params.GetRecordType())
+	if err != nil {
+		// Resolution errors are answers of the resolver, only timeout are handled like other monitorables
+		var dnsError *net.DNSError
+		if errors.As(err, &dnsError) && !dnsError.Timeout() {
+			tile.Status = coreModels.FailedStatus
+			if dnsError.IsNotFound {
+				tile.Message = fmt.Sprintf("no %s record found for %s", params.GetRecordType(), params.Hostname)
+			} else {
+				tile.Message = fmt.Sprintf("unable to resolve %s: %s", params.Hostname, dnsError.Err)
+			}
+			return tile, nil
+		}
+
+		return nil, &coreModels.MonitororError{Err: err, Tile: tile, Message: fmt.Sprintf("unable to resolve %s", params.Hostname)}
+	}
+
+	tile.WithValue(coreModels.MillisecondUnit)
+	tile.Value.Values = []string{fmt.Sprintf("%d", resolution.Latency.Milliseconds())}
+
+	tile.Status = coreModels.SuccessStatus
+	if message := checkRecords(params, resolution.Records); message != "" {
+		tile.Status = coreModels.FailedStatus
+		tile.Message = message
+	} else if resolution.Latency > params.GetWarningLatency() {
+		tile.Status = coreModels.WarningStatus
+		tile.Message = fmt.Sprintf("slow resolution (more than %dms)", params.GetWarningLatency().Milliseconds())
+	}
+
+	return tile, nil
+}
+
+// getLabel return hostname, followed by record type when it's not A
+func getLabel(params *models.DNSParams) string {
+	if params.GetRecordType() == models.ARecordType {
+		return params.Hostname
+	}
+	return fmt.Sprintf("%s (%s)", params.Hostname, params.GetRecordType())
+}
+
+// checkRecords compare records with expected ones and regex, return a message when they don't match
+func checkRecords(params *models.DNSParams, records []string) string {
+	if len(params.Expected) > 0 {
+		actual := normalizeRecords(params.GetRecordType(), records)
+		expected := normalizeRecords(params.GetRecordType(), params.Expected)
+		if strings.Join(actual, "\n") != strings.Join(expected, "\n") {
+			if len(records) == 0 {
+				return "no record found"
+			}
+			return fmt.Sprintf("unexpected records: %s", strings.Join(records, ", "))
+		}
+	}
+
+	if regex := params.GetRegex(); regex != nil {
+		for _, record := range records {
+			if !regex.MatchString(record) {
+				return fmt.Sprintf("unexpected record: %s", record)
+			}
+		}
+	}
+
+	return ""
+}
+
+// normalizeRecords format and sort records to compare them, TXT records are compared as is
+func normalizeRecords(recordType models.RecordType, records []string) []string {
+	var normalized []string
+	for _, record := range records {
+		switch recordType {
+		case models.TXTRecordType:
+			// Case sensitive, kept as is
+		case models.ARecordType, models.AAAARecordType:
+			if ip := net.ParseIP(strings.TrimSpace(record)); ip != nil {
+				record = ip.String()
+			}
+		default:
+			record = strings.TrimSuffix(strings.ToLower(strings.Join(strings.Fields(record), " ")), ".")
+		}
+		normalized = append(normalized, record)
+	}
+	sort.Strings(normalized)
+
+	return normalized
+}
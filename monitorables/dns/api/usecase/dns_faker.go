@@ -0,0 +1,63 @@
+//+build faker
+
+package usecase
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/faker"
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/dns/api"
+	"github.com/monitoror/monitoror/monitorables/dns/api/models"
+	"github.com/monitoror/monitoror/pkg/nonempty"
+)
+
+type (
+	dnsUsecase struct {
+		timeRefByLabel map[string]time.Time
+	}
+)
+
+var availableStatuses = faker.Statuses{
+	{coreModels.SuccessStatus, time.Second * 30},
+	{coreModels.WarningStatus, time.Second * 10},
+	{coreModels.FailedStatus, time.Second * 20},
+}
+
+func NewDNSUsecase() api.Usecase {
+	return &dnsUsecase{make(map[string]time.Time)}
+}
+
+func (du *dnsUsecase) DNS(params *models.DNSParams) (*coreModels.Tile, error) {
+	tile := coreModels.NewTile(api.DNSTileType)
+	tile.Label = params.Hostname
+	if params.GetRecordType() != models.ARecordType {
+		tile.Label = fmt.Sprintf("%s (%s)", params.Hostname, params.GetRecordType())
+	}
+
+	tile.Status = nonempty.Struct(params.Status, du.computeStatus(tile.Label)).(coreModels.TileStatus)
+	tile.Message = params.Message
+
+	tile.WithValue(coreModels.MillisecondUnit)
+	tile.Value.Values = params.ValueValues
+	if len(tile.Value.Values) == 0 {
+		latency := rand.Int63n(params.GetWarningLatency().Milliseconds())
+		if tile.Status == coreModels.WarningStatus {
+			latency += params.GetWarningLatency().Milliseconds()
+		}
+		tile.Value.Values = []string{fmt.Sprintf("%d", latency)}
+	}
+
+	return tile, nil
+}
+
+func (du *dnsUsecase) computeStatus(label string) coreModels.TileStatus {
+	value, ok := du.timeRefByLabel[label]
+	if !ok {
+		du.timeRefByLabel[label] = faker.GetRefTime()
+	}
+
+	return faker.ComputeStatus(value, availableStatuses)
+}
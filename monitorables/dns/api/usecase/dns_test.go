@@ -0,0 +1,171 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/dns/api"
+	"github.com/monitoror/monitoror/monitorables/dns/api/mocks"
+	"github.com/monitoror/monitoror/monitorables/dns/api/models"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	. "github.com/stretchr/testify/mock"
+)
+
+func TestUsecase_DNS(t *testing.T) {
+	for _, testcase := range []struct {
+		params          *models.DNSParams
+		resolution      *models.Resolution
+		expectedLabel   string
+		expectedStatus  coreModels.TileStatus
+		expectedMessage string
+	}{
+		{
+			params:         &models.DNSParams{Hostname: "example.com"},
+			resolution:     &models.Resolution{Records: []string{"93.184.216.34"}, Latency: 12 * time.Millisecond},
+			expectedLabel:  "example.com",
+			expectedStatus: coreModels.SuccessStatus,
+		},
+		{
+			params:          &models.DNSParams{Hostname: "example.com"},
+			resolution:      &models.Resolution{Records: []string{"93.184.216.34"}, Latency: 700 * time.Millisecond},
+			expectedLabel:   "example.com",
+			expectedStatus:  coreModels.WarningStatus,
+			expectedMessage: "slow resolution (more than 500ms)",
+		},
+		{
+			params:         &models.DNSParams{Hostname: "example.com", WarningLatency: pointer.ToInt(1000)},
+			resolution:     &models.Resolution{Records: []string{"93.184.216.34"}, Latency: 700 * time.Millisecond},
+			expectedLabel:  "example.com",
+			expectedStatus: coreModels.SuccessStatus,
+		},
+		{
+			params:         &models.DNSParams{Hostname: "example.com", RecordType: models.AAAARecordType, Expected: []string{"2001:DB8::2", "2001:db8::1"}},
+			resolution:     &models.Resolution{Records: []string{"2001:db8::1", "2001:db8::2"}, Latency: 12 * time.Millisecond},
+			expectedLabel:  "example.com (AAAA)",
+			expectedStatus: coreModels.SuccessStatus,
+		},
+		{
+			params:         &models.DNSParams{Hostname: "example.com", RecordType: models.MXRecordType, Expected: []string{"10  Mail.example.com."}},
+			resolution:     &models.Resolution{Records: []string{"10 mail.example.com"}, Latency: 12 * time.Millisecond},
+			expectedLabel:  "example.com (MX)",
+			expectedStatus: coreModels.SuccessStatus,
+		},
+		{
+			params:          &models.DNSParams{Hostname: "example.com", RecordType: models.TXTRecordType, Expected: []string{"V=SPF1 -all"}},
+			resolution:      &models.Resolution{Records: []string{"v=spf1 -all"}, Latency: 12 * time.Millisecond},
+			expectedLabel:   "example.com (TXT)",
+			expectedStatus:  coreModels.FailedStatus,
+			expectedMessage: "unexpected records: v=spf1 -all",
+		},
+		{
+			params:          &models.DNSParams{Hostname: "example.com", Expected: []string{"93.184.216.34"}},
+			resolution:      &models.Resolution{Records: []string{"93.184.216.34", "10.0.0.1"}, Latency: 700 * time.Millisecond},
+			expectedLabel:   "example.com",
+			expectedStatus:  coreModels.FailedStatus,
+			expectedMessage: "unexpected records: 93.184.216.34, 10.0.0.1",
+		},
+		{
+			params:          &models.DNSParams{Hostname: "example.com", Expected: []string{"93.184.216.34"}},
+			resolution:      &models.Resolution{Latency: 12 * time.Millisecond},
+			expectedLabel:   "example.com",
+			expectedStatus:  coreModels.FailedStatus,
+			expectedMessage: "no record found",
+		},
+		{
+			params:         &models.DNSParams{Hostname: "www.example.com", RecordType: models.CNAMERecordType, Regex: `\.cdn\.example\.net$`},
+			resolution:     &models.Resolution{Records: []string{"edge.cdn.example.net"}, Latency: 12 * time.Millisecond},
+			expectedLabel:  "www.example.com (CNAME)",
+			expectedStatus: coreModels.SuccessStatus,
+		},
+		{
+			params:          &models.DNSParams{Hostname: "www.example.com", RecordType: models.CNAMERecordType, Regex: `\.cdn\.example\.net$`},
+			resolution:      &models.Resolution{Records: []string{"www.example.com"}, Latency: 12 * time.Millisecond},
+			expectedLabel:   "www.example.com (CNAME)",
+			expectedStatus:  coreModels.FailedStatus,
+			expectedMessage: "unexpected record: www.example.com",
+		},
+	} {
+		mockRepo := new(mocks.Repository)
+		mockRepo.On("Resolve", testcase.params.Hostname, testcase.params.GetRecordType()).Return(testcase.resolution, nil)
+		usecase := NewDNSUsecase(mockRepo)
+
+		tile, err := usecase.DNS(testcase.params)
+		if assert.NoError(t, err) {
+			assert.Equal(t, api.DNSTileType, tile.Type)
+			assert.Equal(t, testcase.expectedLabel, tile.Label)
+			assert.Equal(t, testcase.expectedStatus, tile.Status)
+			assert.Equal(t, testcase.expectedMessage, tile.Message)
+			assert.Equal(t, coreModels.MillisecondUnit, tile.Value.Unit)
+			assert.Equal(t, []string{fmt.Sprintf("%d", testcase.resolution.Latency.Milliseconds())}, tile.Value.Values)
+			mockRepo.AssertNumberOfCalls(t, "Resolve", 1)
+			mockRepo.AssertExpectations(t)
+		}
+	}
+}
+
+func TestUsecase_DNS_Error(t *testing.T) {
+	for _, testcase := range []struct {
+		params          *models.DNSParams
+		err             error
+		expectedStatus  coreModels.TileStatus
+		expectedMessage string
+	}{
+		{
+			params:          &models.DNSParams{Hostname: "missing.example.com"},
+			err:             &net.DNSError{Err: "no such host", Name: "missing.example.com", IsNotFound: true},
+			expectedStatus:  coreModels.FailedStatus,
+			expectedMessage: "no A record found for missing.example.com",
+		},
+		{
+			params:          &models.DNSParams{Hostname: "example.com", RecordType: models.MXRecordType},
+			err:             &net.DNSError{Err: "no such host", Name: "example.com", IsNotFound: true},
+			expectedStatus:  coreModels.FailedStatus,
+			expectedMessage: "no MX record found for example.com",
+		},
+		{
+			params:          &models.DNSParams{Hostname: "broken.example.com"},
+			err:             &net.DNSError{Err: "server misbehaving", Name: "broken.example.com"},
+			expectedStatus:  coreModels.FailedStatus,
+			expectedMessage: "unable to resolve broken.example.com: server misbehaving",
+		},
+	} {
+		mockRepo := new(mocks.Repository)
+		mockRepo.On("Resolve", AnythingOfType("string"), AnythingOfType("models.RecordType")).Return(nil, testcase.err)
+		usecase := NewDNSUsecase(mockRepo)
+
+		tile, err := usecase.DNS(testcase.params)
+		if assert.NoError(t, err) {
+			assert.Equal(t, testcase.expectedStatus, tile.Status)
+			assert.Equal(t, testcase.expectedMessage, tile.Message)
+			assert.Nil(t, tile.Value)
+			mockRepo.AssertNumberOfCalls(t, "Resolve", 1)
+			mockRepo.AssertExpectations(t)
+		}
+	}
+}
+
+func TestUsecase_DNS_Timeout(t *testing.T) {
+	for _, resolveErr := range []error{
+		&net.DNSError{Err: "i/o timeout", Name: "slow.example.com", IsTimeout: true},
+		errors.New("boom"),
+	} {
+		mockRepo := new(mocks.Repository)
+		mockRepo.On("Resolve", "slow.example.com", models.ARecordType).Return(nil, resolveErr)
+		usecase := NewDNSUsecase(mockRepo)
+
+		tile, err := usecase.DNS(&models.DNSParams{Hostname: "slow.example.com"})
+		assert.Nil(t, tile)
+		if assert.Error(t, err) {
+			assert.IsType(t, &coreModels.MonitororError{}, err)
+			assert.Equal(t, "unable to resolve slow.example.com", err.Error())
+			mockRepo.AssertNumberOfCalls(t, "Resolve", 1)
+			mockRepo.AssertExpectations(t)
+		}
+	}
+}
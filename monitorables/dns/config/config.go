@@ -0,0 +1,50 @@
+package config
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+)
+
+type (
+	DNS struct {
+		// Resolver is the DNS server used to resolve records, as "host" or "host:port" (ex: "1.1.1.1", "[::1]:5353")
+		// Empty to use system resolver
+		Resolver string
+		Timeout  int `validate:"gte=0"` // In Millisecond
+	}
+)
+
+var Default = &DNS{
+	Resolver: "",
+	Timeout:  2000,
+}
+
+const defaultResolverPort = 53
+
+// GetResolverAddress return resolver "host:port", empty when system resolver is used
+func (c *DNS) GetResolverAddress() (string, error) {
+	if c.Resolver == "" {
+		return "", nil
+	}
+
+	// IPv6 without brackets and port
+	if ip := net.ParseIP(c.Resolver); ip != nil {
+		return net.JoinHostPort(ip.String(), strconv.Itoa(defaultResolverPort)), nil
+	}
+
+	host, rawPort, err := net.SplitHostPort(c.Resolver)
+	if err != nil {
+		// Without port
+		if host, _, err = net.SplitHostPort(c.Resolver + ":0"); err != nil {
+			return "", fmt.Errorf("invalid resolver %q", c.Resolver)
+		}
+		rawPort = strconv.Itoa(defaultResolverPort)
+	}
+
+	if port, err := strconv.Atoi(rawPort); err != nil || port <= 0 || port > 65535 || host == "" {
+		return "", fmt.Errorf("invalid resolver %q", c.Resolver)
+	}
+
+	return net.JoinHostPort(host, rawPort), nil
+}
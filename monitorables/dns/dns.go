@@ -0,0 +1,78 @@
+//+build !faker
+
+package dns
+
+import (
+	"github.com/monitoror/monitoror/api/config/versions"
+	pkgMonitorable "github.com/monitoror/monitoror/internal/pkg/monitorable"
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/dns/api"
+	dnsDelivery "github.com/monitoror/monitoror/monitorables/dns/api/delivery/http"
+	dnsModels "github.com/monitoror/monitoror/monitorables/dns/api/models"
+	dnsRepository "github.com/monitoror/monitoror/monitorables/dns/api/repository"
+	dnsUsecase "github.com/monitoror/monitoror/monitorables/dns/api/usecase"
+	dnsConfig "github.com/monitoror/monitoror/monitorables/dns/config"
+	"github.com/monitoror/monitoror/registry"
+	"github.com/monitoror/monitoror/store"
+)
+
+type Monitorable struct {
+	store *store.Store
+
+	config map[coreModels.VariantName]*dnsConfig.DNS
+
+	// Config tile settings
+	dnsTileEnabler registry.TileEnabler
+}
+
+func NewMonitorable(store *store.Store) *Monitorable {
+	m := &Monitorable{}
+	m.store = store
+	m.config = make(map[coreModels.VariantName]*dnsConfig.DNS)
+
+	// Load core config from env
+	pkgMonitorable.LoadConfig(&m.config, dnsConfig.Default)
+
+	// Register Monitorable Tile in config manager
+	m.dnsTileEnabler = store.Registry.RegisterTile(api.DNSTileType, versions.MinimalVersion, m.GetVariantsNames())
+
+	return m
+}
+
+func (m *Monitorable) GetDisplayName() string {
+	return "DNS"
+}
+
+func (m *Monitorable) GetVariantsNames() []coreModels.VariantName {
+	return pkgMonitorable.GetVariantsNames(m.config)
+}
+
+func (m *Monitorable) Validate(variantName coreModels.VariantName) (bool, []error) {
+	conf := m.config[variantName]
+
+	// Validate Config
+	if errors := pkgMonitorable.ValidateConfig(conf, variantName); errors != nil {
+		return false, errors
+	}
+
+	if _, err := conf.GetResolverAddress(); err != nil {
+		return false, []error{err}
+	}
+
+	return true, nil
+}
+
+func (m *Monitorable) Enable(variantName coreModels.VariantName) {
+	conf := m.config[variantName]
+
+	repository := dnsRepository.NewDNSRepository(conf)
+	usecase := dnsUsecase.NewDNSUsecase(repository)
+	delivery := dnsDelivery.NewDNSDelivery(usecase)
+
+	// EnableTile route to echo
+	routeGroup := m.store.MonitorableRouter.Group("/dns", variantName)
+	route := routeGroup.GET("/dns", delivery.GetDNS)
+
+	// EnableTile data for config hydration
+	m.dnsTileEnabler.Enable(variantName, &dnsModels.DNSParams{}, route.Path)
+}
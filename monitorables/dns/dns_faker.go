@@ -0,0 +1,48 @@
+//+build faker
+
+package dns
+
+import (
+	"github.com/monitoror/monitoror/api/config/versions"
+	"github.com/monitoror/monitoror/internal/pkg/monitorable"
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/dns/api"
+	dnsDelivery "github.com/monitoror/monitoror/monitorables/dns/api/delivery/http"
+	dnsModels "github.com/monitoror/monitoror/monitorables/dns/api/models"
+	dnsUsecase "github.com/monitoror/monitoror/monitorables/dns/api/usecase"
+	"github.com/monitoror/monitoror/registry"
+	"github.com/monitoror/monitoror/store"
+)
+
+type Monitorable struct {
+	monitorable.DefaultMonitorableFaker
+
+	store *store.Store
+
+	// Config tile settings
+	dnsTileEnabler registry.TileEnabler
+}
+
+func NewMonitorable(store *store.Store) *Monitorable {
+	m := &Monitorable{}
+	m.store = store
+
+	// Register Monitorable Tile in config manager
+	m.dnsTileEnabler = store.Registry.RegisterTile(api.DNSTileType, versions.MinimalVersion, m.GetVariantsNames())
+
+	return m
+}
+
+func (m *Monitorable) GetDisplayName() string { return "DNS" }
+
+func (m *Monitorable) Enable(variantName coreModels.VariantName) {
+	usecase := dnsUsecase.NewDNSUsecase()
+	delivery := dnsDelivery.NewDNSDelivery(usecase)
+
+	// EnableTile route to echo
+	routeGroup := m.store.MonitorableRouter.Group("/dns", variantName)
+	route := routeGroup.GET("/dns", delivery.GetDNS)
+
+	// EnableTile data for config hydration
+	m.dnsTileEnabler.Enable(variantName, &dnsModels.DNSParams{}, route.Path)
+}
@@ -0,0 +1,50 @@
+package dns
+
+import (
+	"os"
+	"testing"
+
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMonitorable(t *testing.T) {
+	// init Store
+	store, mockMonitorableHelper := test.InitMockAndStore()
+
+	// init Env
+	// Wrong Timeout
+	_ = os.Setenv("MO_MONITORABLE_DNS_VARIANT0_TIMEOUT", "-1000")
+	// Wrong Resolver
+	_ = os.Setenv("MO_MONITORABLE_DNS_VARIANT1_RESOLVER", "1.1.1.1:dns")
+	// Valid Resolver
+	_ = os.Setenv("MO_MONITORABLE_DNS_VARIANT2_RESOLVER", "[2606:4700:4700::1111]:53")
+	_ = os.Setenv("MO_MONITORABLE_DNS_VARIANT3_RESOLVER", "2606:4700:4700::1111")
+
+	// NewMonitorable
+	monitorable := NewMonitorable(store)
+	assert.NotNil(t, monitorable)
+
+	// GetDisplayName
+	assert.NotNil(t, monitorable.GetDisplayName())
+
+	// GetVariantsNames and check
+	if assert.Len(t, monitorable.GetVariantsNames(), 5) {
+		_, errors := monitorable.Validate("variant0")
+		assert.NotEmpty(t, errors)
+		_, errors = monitorable.Validate("variant1")
+		assert.NotEmpty(t, errors)
+	}
+
+	// Enable
+	for _, variantName := range monitorable.GetVariantsNames() {
+		if valid, _ := monitorable.Validate(variantName); valid {
+			monitorable.Enable(variantName)
+		}
+	}
+
+	// Test calls
+	mockMonitorableHelper.RouterAssertNumberOfCalls(t, 3, 3)
+	mockMonitorableHelper.TileSettingsManagerAssertNumberOfCalls(t, 1, 0, 3, 0)
+}
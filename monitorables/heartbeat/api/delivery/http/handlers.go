@@ -0,0 +1,69 @@
+package http
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/delivery"
+	"github.com/monitoror/monitoror/monitorables/heartbeat/api"
+	"github.com/monitoror/monitoror/monitorables/heartbeat/api/models"
+
+	"github.com/labstack/echo/v4"
+)
+
+const KeyPathParam = "key"
+
+type HeartbeatDelivery struct {
+	heartbeatUsecase api.Usecase
+
+	// token expected in Authorization header of pings
+	token string
+}
+
+func NewHeartbeatDelivery(h api.Usecase, token string) *HeartbeatDelivery {
+	return &HeartbeatDelivery{h, token}
+}
+
+func (h *HeartbeatDelivery) GetHeartbeat(c echo.Context) error {
+	// Bind / check Params
+	params := &models.HeartbeatParams{}
+	if err := delivery.BindAndValidateParams(c, params); err != nil {
+		return err
+	}
+
+	tile, err := h.heartbeatUsecase.Heartbeat(params)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, tile)
+}
+
+// Start mark the beginning of a run, used to compute its duration
+func (h *HeartbeatDelivery) Start(c echo.Context) error {
+	return h.ping(c, h.heartbeatUsecase.Start)
+}
+
+// Ping mark a run as done
+func (h *HeartbeatDelivery) Ping(c echo.Context) error {
+	return h.ping(c, h.heartbeatUsecase.Ping)
+}
+
+func (h *HeartbeatDelivery) ping(c echo.Context, ping func(key string) error) error {
+	authorization := c.Request().Header.Get(echo.HeaderAuthorization)
+	if subtle.ConstantTimeCompare([]byte(authorization), []byte(fmt.Sprintf("Bearer %s", h.token))) != 1 {
+		return echo.ErrUnauthorized
+	}
+
+	key := c.Param(KeyPathParam)
+	if !models.KeyRegex.MatchString(key) {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid key, must match %s", models.KeyRegex.String()))
+	}
+
+	if err := ping(key); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,135 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/heartbeat/api"
+	"github.com/monitoror/monitoror/monitorables/heartbeat/api/mocks"
+	heartbeatModels "github.com/monitoror/monitoror/monitorables/heartbeat/api/models"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	. "github.com/stretchr/testify/mock"
+)
+
+func initEcho() (ctx echo.Context, res *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(echo.GET, "/api/v1/heartbeat/default/heartbeat", nil)
+	res = httptest.NewRecorder()
+	ctx = e.NewContext(req, res)
+
+	ctx.QueryParams().Set("key", "backup")
+	ctx.QueryParams().Set("period", "60000")
+
+	return
+}
+
+func initPingEcho(key, authorization string) (ctx echo.Context, res *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(echo.GET, "/api/v1/heartbeat/default/ping/key", nil)
+	req.Header.Set(echo.HeaderAuthorization, authorization)
+	res = httptest.NewRecorder()
+	ctx = e.NewContext(req, res)
+
+	ctx.SetParamNames(KeyPathParam)
+	ctx.SetParamValues(key)
+
+	return
+}
+
+func TestDelivery_GetHeartbeat_Success(t *testing.T) {
+	// Init
+	ctx, res := initEcho()
+
+	tile := models.NewTile(api.HeartbeatTileType)
+	tile.Status = models.SuccessStatus
+
+	mockUsecase := new(mocks.Usecase)
+	mockUsecase.On("Heartbeat", &heartbeatModels.HeartbeatParams{Key: "backup", Period: pointer.ToInt(60000)}).Return(tile, nil)
+	handler := NewHeartbeatDelivery(mockUsecase, "token")
+
+	// Expected
+	json, err := json.Marshal(tile)
+	assert.NoError(t, err, "unable to marshal tile")
+
+	// Test
+	if assert.NoError(t, handler.GetHeartbeat(ctx)) {
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(json), strings.TrimSpace(res.Body.String()))
+		mockUsecase.AssertNumberOfCalls(t, "Heartbeat", 1)
+		mockUsecase.AssertExpectations(t)
+	}
+}
+
+func TestDelivery_GetHeartbeat_Error(t *testing.T) {
+	// Init
+	ctx, _ := initEcho()
+
+	mockUsecase := new(mocks.Usecase)
+	mockUsecase.On("Heartbeat", Anything).Return(nil, errors.New("heartbeat error"))
+	handler := NewHeartbeatDelivery(mockUsecase, "token")
+
+	// Test
+	assert.Error(t, handler.GetHeartbeat(ctx))
+	mockUsecase.AssertNumberOfCalls(t, "Heartbeat", 1)
+}
+
+func TestDelivery_Ping_Success(t *testing.T) {
+	mockUsecase := new(mocks.Usecase)
+	mockUsecase.On("Start", "backup").Return(nil)
+	mockUsecase.On("Ping", "backup").Return(nil)
+	handler := NewHeartbeatDelivery(mockUsecase, "token")
+
+	// Test
+	ctx, res := initPingEcho("backup", "Bearer token")
+	if assert.NoError(t, handler.Start(ctx)) {
+		assert.Equal(t, http.StatusNoContent, res.Code)
+	}
+
+	ctx, res = initPingEcho("backup", "Bearer token")
+	if assert.NoError(t, handler.Ping(ctx)) {
+		assert.Equal(t, http.StatusNoContent, res.Code)
+	}
+
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestDelivery_Ping_Error(t *testing.T) {
+	for _, testcase := range []struct {
+		key, authorization string
+		expectedCode       int
+	}{
+		{"backup", "", http.StatusUnauthorized},
+		{"backup", "Bearer wrong", http.StatusUnauthorized},
+		{"backup db", "Bearer token", http.StatusBadRequest},
+	} {
+		ctx, _ := initPingEcho(testcase.key, testcase.authorization)
+
+		mockUsecase := new(mocks.Usecase)
+		handler := NewHeartbeatDelivery(mockUsecase, "token")
+
+		err := handler.Ping(ctx)
+		if assert.Error(t, err) && assert.IsType(t, &echo.HTTPError{}, err) {
+			assert.Equal(t, testcase.expectedCode, err.(*echo.HTTPError).Code)
+		}
+		mockUsecase.AssertNumberOfCalls(t, "Ping", 0)
+	}
+}
+
+func TestDelivery_Ping_UsecaseError(t *testing.T) {
+	ctx, _ := initPingEcho("backup", "Bearer token")
+
+	mockUsecase := new(mocks.Usecase)
+	mockUsecase.On("Ping", "backup").Return(errors.New("boom"))
+	handler := NewHeartbeatDelivery(mockUsecase, "token")
+
+	assert.Error(t, handler.Ping(ctx))
+	mockUsecase.AssertNumberOfCalls(t, "Ping", 1)
+}
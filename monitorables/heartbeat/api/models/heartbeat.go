@@ -0,0 +1,34 @@
+package models
+
+import (
+	"regexp"
+	"time"
+)
+
+type (
+	// Heartbeat is the state of a key, updated by pings of scheduled jobs
+	Heartbeat struct {
+		// LastPingAt is the time of the last ping marking a run as done
+		LastPingAt *time.Time `json:"lastPingAt,omitempty"`
+		// StartedAt is the time of the last start ping, nil if job doesn't send start pings
+		StartedAt *time.Time `json:"startedAt,omitempty"`
+		// LastDuration is the duration of the last run, between start ping and next ping
+		LastDuration *time.Duration `json:"lastDuration,omitempty"`
+	}
+)
+
+// KeyRegex of heartbeat keys, used in ping URL
+var KeyRegex = regexp.MustCompile(`^[a-zA-Z0-9_.-]{1,128}$`)
+
+// IsRunning return true if a start ping was received after the last ping
+func (h *Heartbeat) IsRunning() bool {
+	return h.StartedAt != nil && (h.LastPingAt == nil || h.StartedAt.After(*h.LastPingAt))
+}
+
+// LastSeenAt return time of the last ping, start pings included
+func (h *Heartbeat) LastSeenAt() time.Time {
+	if h.IsRunning() {
+		return *h.StartedAt
+	}
+	return *h.LastPingAt
+}
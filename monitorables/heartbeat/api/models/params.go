@@ -0,0 +1,44 @@
+//+build !faker
+
+package models
+
+import (
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/params"
+	"github.com/monitoror/monitoror/internal/pkg/validator"
+
+	"github.com/robfig/cron/v3"
+)
+
+type (
+	HeartbeatParams struct {
+		params.Default
+
+		Key    string `json:"key" query:"key" validate:"required"`
+		Period *int   `json:"period,omitempty" query:"period" validate:"omitempty,gt=0"` // In Millisecond
+		Cron   string `json:"cron,omitempty" query:"cron"`                               // Standard cron expression, replace period
+		Grace  *int   `json:"grace,omitempty" query:"grace" validate:"omitempty,gte=0"`  // In Millisecond, override core configuration
+	}
+)
+
+func (p *HeartbeatParams) Validate() []validator.Error {
+	if p.Key != "" && !KeyRegex.MatchString(p.Key) {
+		return []validator.Error{validator.NewDefaultError("Key", KeyRegex.String())}
+	}
+
+	if (p.Period == nil) == (p.Cron == "") {
+		return []validator.Error{validator.NewDefaultError("Period", "period or cron (but not both)")}
+	}
+
+	if p.Cron != "" {
+		if _, err := p.Schedule(); err != nil {
+			return []validator.Error{validator.NewDefaultError("Cron", "valid cron expression (ex: \"0 3 * * *\")")}
+		}
+	}
+
+	return nil
+}
+
+// Schedule parse cron expression of params
+func (p *HeartbeatParams) Schedule() (cron.Schedule, error) {
+	return cron.ParseStandard(p.Cron)
+}
@@ -0,0 +1,22 @@
+//+build faker
+
+package models
+
+import (
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/params"
+	coreModels "github.com/monitoror/monitoror/models"
+)
+
+type (
+	HeartbeatParams struct {
+		params.Default
+
+		Key    string `json:"key" query:"key" validate:"required"`
+		Period *int   `json:"period,omitempty" query:"period"`
+		Cron   string `json:"cron,omitempty" query:"cron"`
+		Grace  *int   `json:"grace,omitempty" query:"grace"`
+
+		Status  coreModels.TileStatus `json:"status" query:"status"`
+		Message string                `json:"message" query:"message"`
+	}
+)
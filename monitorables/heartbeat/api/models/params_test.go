@@ -0,0 +1,38 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/test"
+
+	"github.com/AlekSi/pointer"
+)
+
+func TestHeartbeatParams_Validate(t *testing.T) {
+	param := &HeartbeatParams{}
+	test.AssertParams(t, param, 2)
+
+	param = &HeartbeatParams{Key: "backup/db", Period: pointer.ToInt(60000)}
+	test.AssertParams(t, param, 1)
+
+	param = &HeartbeatParams{Key: "backup"}
+	test.AssertParams(t, param, 1)
+
+	param = &HeartbeatParams{Key: "backup", Period: pointer.ToInt(60000), Cron: "0 3 * * *"}
+	test.AssertParams(t, param, 1)
+
+	param = &HeartbeatParams{Key: "backup", Period: pointer.ToInt(0)}
+	test.AssertParams(t, param, 1)
+
+	param = &HeartbeatParams{Key: "backup", Cron: "every night"}
+	test.AssertParams(t, param, 1)
+
+	param = &HeartbeatParams{Key: "backup", Cron: "0 3 * * *", Grace: pointer.ToInt(-1)}
+	test.AssertParams(t, param, 1)
+
+	param = &HeartbeatParams{Key: "backup", Period: pointer.ToInt(60000), Grace: pointer.ToInt(0)}
+	test.AssertParams(t, param, 0)
+
+	param = &HeartbeatParams{Key: "backup", Cron: "CRON_TZ=Europe/Paris 0 3 * * 1-5"}
+	test.AssertParams(t, param, 0)
+}
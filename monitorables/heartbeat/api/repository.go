@@ -0,0 +1,14 @@
+//go:generate mockery -name Repository
+
+package api
+
+import (
+	"github.com/monitoror/monitoror/monitorables/heartbeat/api/models"
+)
+
+type (
+	Repository interface {
+		GetHeartbeat(key string) (*models.Heartbeat, error)
+		SetHeartbeat(key string, heartbeat *models.Heartbeat) error
+	}
+)
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/heartbeat/api"
+	"github.com/monitoror/monitoror/monitorables/heartbeat/api/models"
+)
+
+type (
+	// fileHeartbeatRepository keep heartbeats of a variant in <dir>/<variant>.json, so they survive restarts
+	fileHeartbeatRepository struct {
+		path string
+
+		// mutex protect heartbeats and file
+		mutex sync.Mutex
+		// heartbeats by key, loaded from file on first access
+		heartbeats map[string]*models.Heartbeat
+	}
+)
+
+func NewFileHeartbeatRepository(dir string, variantName coreModels.VariantName) api.Repository {
+	return &fileHeartbeatRepository{path: filepath.Join(dir, string(variantName)+".json")}
+}
+
+// GetHeartbeat return state of key, nil if key was never pinged
+func (r *fileHeartbeatRepository) GetHeartbeat(key string) (*models.Heartbeat, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	heartbeat, ok := r.heartbeats[key]
+	if !ok {
+		return nil, nil
+	}
+
+	copied := *heartbeat
+	return &copied, nil
+}
+
+func (r *fileHeartbeatRepository) SetHeartbeat(key string, heartbeat *models.Heartbeat) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if err := r.load(); err != nil {
+		return err
+	}
+
+	copied := *heartbeat
+	r.heartbeats[key] = &copied
+
+	return r.save()
+}
+
+// load read file once, a missing file is an empty store
+func (r *fileHeartbeatRepository) load() error {
+	if r.heartbeats != nil {
+		return nil
+	}
+
+	heartbeats := make(map[string]*models.Heartbeat)
+	data, err := ioutil.ReadFile(r.path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err == nil {
+		if err := json.Unmarshal(data, &heartbeats); err != nil {
+			return err
+		}
+	}
+
+	r.heartbeats = heartbeats
+	return nil
+}
+
+// save write heartbeats in a temporary file renamed over the previous one, file is never partially written
+func (r *fileHeartbeatRepository) save() error {
+	data, err := json.Marshal(r.heartbeats)
+	if err != nil {
+		return err
+	}
+
+	tmpFile, err := ioutil.TempFile(filepath.Dir(r.path), filepath.Base(r.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := tmpFile.Write(data); err != nil {
+		_ = tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile.Name(), r.path)
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/monitoror/monitoror/monitorables/heartbeat/api/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileHeartbeatRepository(t *testing.T) {
+	dir, err := ioutil.TempDir("", "monitoror")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	repository := NewFileHeartbeatRepository(dir, "default")
+
+	heartbeat, err := repository.GetHeartbeat("backup")
+	assert.NoError(t, err)
+	assert.Nil(t, heartbeat)
+
+	now := time.Now().Round(0)
+	expected := &models.Heartbeat{LastPingAt: &now}
+	assert.NoError(t, repository.SetHeartbeat("backup", expected))
+
+	// Heartbeats are reloaded from file, like after a restart
+	restartedRepository := NewFileHeartbeatRepository(dir, "default")
+	heartbeat, err = restartedRepository.GetHeartbeat("backup")
+	if assert.NoError(t, err) && assert.NotNil(t, heartbeat) {
+		assert.True(t, expected.LastPingAt.Equal(*heartbeat.LastPingAt))
+	}
+
+	// Keys are isolated by variant
+	heartbeat, err = NewFileHeartbeatRepository(dir, "other").GetHeartbeat("backup")
+	assert.NoError(t, err)
+	assert.Nil(t, heartbeat)
+}
+
+func TestFileHeartbeatRepository_Error(t *testing.T) {
+	dir, err := ioutil.TempDir("", "monitoror")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "default.json"), []byte("not json"), 0600))
+	repository := NewFileHeartbeatRepository(dir, "default")
+
+	_, err = repository.GetHeartbeat("backup")
+	assert.Error(t, err)
+	assert.Error(t, repository.SetHeartbeat("backup", &models.Heartbeat{}))
+}
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"fmt"
+
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/heartbeat/api"
+	"github.com/monitoror/monitoror/monitorables/heartbeat/api/models"
+
+	"github.com/jsdidierlaurent/echo-middleware/cache"
+)
+
+type (
+	heartbeatRepository struct {
+		store       cache.Store
+		variantName coreModels.VariantName
+	}
+)
+
+// HeartbeatStoreKeyPrefix prefix of heartbeats keys in store
+const HeartbeatStoreKeyPrefix = "monitoror.heartbeat.key"
+
+func NewHeartbeatRepository(store cache.Store, variantName coreModels.VariantName) api.Repository {
+	return &heartbeatRepository{store, variantName}
+}
+
+// GetHeartbeat return state of key, nil if key was never pinged
+func (r *heartbeatRepository) GetHeartbeat(key string) (*models.Heartbeat, error) {
+	heartbeat := models.Heartbeat{}
+	if err := r.store.Get(r.storeKey(key), &heartbeat); err != nil {
+		if err == cache.ErrCacheMiss {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &heartbeat, nil
+}
+
+func (r *heartbeatRepository) SetHeartbeat(key string, heartbeat *models.Heartbeat) error {
+	return r.store.Set(r.storeKey(key), *heartbeat, cache.NEVER)
+}
+
+func (r *heartbeatRepository) storeKey(key string) string {
+	return fmt.Sprintf("%s:%s:%s", HeartbeatStoreKeyPrefix, r.variantName, key)
+}
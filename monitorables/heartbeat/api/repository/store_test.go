@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/monitoror/monitoror/monitorables/heartbeat/api/models"
+
+	"github.com/jsdidierlaurent/echo-middleware/cache"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeartbeatRepository(t *testing.T) {
+	store := cache.NewGoCacheStore(time.Minute, time.Minute)
+	repository := NewHeartbeatRepository(store, "default")
+	otherRepository := NewHeartbeatRepository(store, "other")
+
+	heartbeat, err := repository.GetHeartbeat("backup")
+	assert.NoError(t, err)
+	assert.Nil(t, heartbeat)
+
+	now := time.Now()
+	expected := &models.Heartbeat{LastPingAt: &now}
+	assert.NoError(t, repository.SetHeartbeat("backup", expected))
+
+	heartbeat, err = repository.GetHeartbeat("backup")
+	if assert.NoError(t, err) {
+		assert.Equal(t, expected, heartbeat)
+	}
+
+	// Keys are isolated by variant
+	heartbeat, err = otherRepository.GetHeartbeat("backup")
+	assert.NoError(t, err)
+	assert.Nil(t, heartbeat)
+}
@@ -0,0 +1,20 @@
+//go:generate mockery -name Usecase
+
+package api
+
+import (
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/heartbeat/api/models"
+)
+
+const (
+	HeartbeatTileType coreModels.TileType = "HEARTBEAT"
+)
+
+type (
+	Usecase interface {
+		Heartbeat(params *models.HeartbeatParams) (*coreModels.Tile, error)
+		Start(key string) error
+		Ping(key string) error
+	}
+)
@@ -0,0 +1,130 @@
+//+build !faker
+
+package usecase
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/heartbeat/api"
+	"github.com/monitoror/monitoror/monitorables/heartbeat/api/models"
+)
+
+type (
+	heartbeatUsecase struct {
+		repository api.Repository
+
+		// grace is the delay added to the expected time of the next ping before failing
+		grace time.Duration
+
+		// pingMutex protect read / write of heartbeats on concurrent pings
+		pingMutex sync.Mutex
+	}
+)
+
+func NewHeartbeatUsecase(repository api.Repository, grace int) api.Usecase {
+	return &heartbeatUsecase{repository: repository, grace: time.Duration(grace) * time.Millisecond}
+}
+
+func (hu *heartbeatUsecase) Heartbeat(params *models.HeartbeatParams) (*coreModels.Tile, error) {
+	tile := coreModels.NewTile(api.HeartbeatTileType)
+	tile.Label = params.Key
+
+	heartbeat, err := hu.repository.GetHeartbeat(params.Key)
+	if err != nil {
+		return nil, &coreModels.MonitororError{Err: err, Tile: tile, Message: "unable to get heartbeat"}
+	}
+
+	if heartbeat == nil {
+		tile.Status = coreModels.UnknownStatus
+		tile.Message = "no ping received"
+		return tile, nil
+	}
+
+	deadline, err := hu.deadline(params, heartbeat.LastSeenAt())
+	if err != nil {
+		return nil, &coreModels.MonitororError{Err: err, Tile: tile, Message: "unable to parse cron expression"}
+	}
+
+	tile.WithBuild()
+	if heartbeat.LastDuration != nil {
+		tile.Value = &coreModels.TileValue{
+			Unit:   coreModels.MillisecondUnit,
+			Values: []string{strconv.FormatInt(heartbeat.LastDuration.Milliseconds(), 10)},
+		}
+	}
+
+	lastSeenAt := heartbeat.LastSeenAt()
+	if time.Now().After(deadline) {
+		tile.Status = coreModels.FailedStatus
+		tile.Message = fmt.Sprintf("no ping for %s", time.Since(lastSeenAt).Truncate(time.Second))
+		tile.Build.FinishedAt = &lastSeenAt
+	} else if heartbeat.IsRunning() {
+		tile.Status = coreModels.RunningStatus
+		tile.Build.PreviousStatus = coreModels.SuccessStatus
+		tile.Build.StartedAt = heartbeat.StartedAt
+		if heartbeat.LastDuration != nil {
+			estimatedDuration := int64(heartbeat.LastDuration.Seconds())
+			tile.Build.EstimatedDuration = &estimatedDuration
+		}
+	} else {
+		tile.Status = coreModels.SuccessStatus
+		tile.Build.FinishedAt = &lastSeenAt
+	}
+
+	return tile, nil
+}
+
+// deadline return the time before which next ping is expected
+func (hu *heartbeatUsecase) deadline(params *models.HeartbeatParams, lastSeenAt time.Time) (time.Time, error) {
+	grace := hu.grace
+	if params.Grace != nil {
+		grace = time.Duration(*params.Grace) * time.Millisecond
+	}
+
+	if params.Cron != "" {
+		schedule, err := params.Schedule()
+		if err != nil {
+			return time.Time{}, err
+		}
+		return schedule.Next(lastSeenAt).Add(grace), nil
+	}
+
+	return lastSeenAt.Add(time.Duration(*params.Period) * time.Millisecond).Add(grace), nil
+}
+
+func (hu *heartbeatUsecase) Start(key string) error {
+	return hu.update(key, func(heartbeat *models.Heartbeat, now time.Time) {
+		heartbeat.StartedAt = &now
+	})
+}
+
+func (hu *heartbeatUsecase) Ping(key string) error {
+	return hu.update(key, func(heartbeat *models.Heartbeat, now time.Time) {
+		if heartbeat.IsRunning() {
+			duration := now.Sub(*heartbeat.StartedAt)
+			heartbeat.LastDuration = &duration
+		}
+		heartbeat.LastPingAt = &now
+	})
+}
+
+func (hu *heartbeatUsecase) update(key string, apply func(heartbeat *models.Heartbeat, now time.Time)) error {
+	hu.pingMutex.Lock()
+	defer hu.pingMutex.Unlock()
+
+	heartbeat, err := hu.repository.GetHeartbeat(key)
+	if err != nil {
+		return err
+	}
+	if heartbeat == nil {
+		heartbeat = &models.Heartbeat{}
+	}
+
+	apply(heartbeat, time.Now())
+
+	return hu.repository.SetHeartbeat(key, heartbeat)
+}
@@ -0,0 +1,73 @@
+//+build faker
+
+package usecase
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/faker"
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/heartbeat/api"
+	"github.com/monitoror/monitoror/monitorables/heartbeat/api/models"
+	"github.com/monitoror/monitoror/pkg/nonempty"
+)
+
+type (
+	heartbeatUsecase struct {
+		timeRefByKey map[string]time.Time
+	}
+)
+
+var availableStatuses = faker.Statuses{
+	{coreModels.SuccessStatus, time.Second * 30},
+	{coreModels.RunningStatus, time.Second * 15},
+	{coreModels.FailedStatus, time.Second * 15},
+}
+
+func NewHeartbeatUsecase() api.Usecase {
+	return &heartbeatUsecase{make(map[string]time.Time)}
+}
+
+func (hu *heartbeatUsecase) Heartbeat(params *models.HeartbeatParams) (tile *coreModels.Tile, err error) {
+	tile = coreModels.NewTile(api.HeartbeatTileType).WithBuild()
+	tile.Label = params.Key
+
+	// Code
+	tile.Status = nonempty.Struct(params.Status, hu.computeStatus(params)).(coreModels.TileStatus)
+	tile.Message = params.Message
+
+	if tile.Status == coreModels.RunningStatus {
+		startedAt := time.Now().Add(-time.Second * 10)
+		estimatedDuration := int64(30)
+		tile.Build.PreviousStatus = coreModels.SuccessStatus
+		tile.Build.StartedAt = &startedAt
+		tile.Build.EstimatedDuration = &estimatedDuration
+	} else {
+		finishedAt := time.Now().Add(-time.Minute * 5)
+		tile.Build.FinishedAt = &finishedAt
+	}
+
+	tile.Value = &coreModels.TileValue{Unit: coreModels.MillisecondUnit, Values: []string{strconv.Itoa(30000)}}
+
+	return
+}
+
+// Start is ignored with faker
+func (hu *heartbeatUsecase) Start(_ string) error {
+	return nil
+}
+
+// Ping is ignored with faker
+func (hu *heartbeatUsecase) Ping(_ string) error {
+	return nil
+}
+
+func (hu *heartbeatUsecase) computeStatus(params *models.HeartbeatParams) coreModels.TileStatus {
+	value, ok := hu.timeRefByKey[params.Key]
+	if !ok {
+		hu.timeRefByKey[params.Key] = faker.GetRefTime()
+	}
+
+	return faker.ComputeStatus(value, availableStatuses)
+}
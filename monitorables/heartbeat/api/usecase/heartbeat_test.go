@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/heartbeat/api"
+	"github.com/monitoror/monitoror/monitorables/heartbeat/api/mocks"
+	"github.com/monitoror/monitoror/monitorables/heartbeat/api/models"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	. "github.com/stretchr/testify/mock"
+)
+
+func TestUsecase_Heartbeat(t *testing.T) {
+	now := time.Now()
+	minuteAgo := now.Add(-time.Minute)
+	hourAgo := now.Add(-time.Hour)
+	duration := 30 * time.Second
+	estimatedDuration := int64(30)
+	value := &coreModels.TileValue{Unit: coreModels.MillisecondUnit, Values: []string{"30000"}}
+
+	for _, testcase := range []struct {
+		heartbeat    *models.Heartbeat
+		params       *models.HeartbeatParams
+		expectedTile *coreModels.Tile
+	}{
+		{
+			heartbeat:    nil,
+			params:       &models.HeartbeatParams{Period: pointer.ToInt(3600000)},
+			expectedTile: &coreModels.Tile{Status: coreModels.UnknownStatus, Message: "no ping received"},
+		},
+		{
+			heartbeat: &models.Heartbeat{LastPingAt: &minuteAgo},
+			params:    &models.HeartbeatParams{Period: pointer.ToInt(3600000)},
+			expectedTile: &coreModels.Tile{
+				Status: coreModels.SuccessStatus,
+				Build:  &coreModels.TileBuild{FinishedAt: &minuteAgo},
+			},
+		},
+		{
+			heartbeat: &models.Heartbeat{LastPingAt: &minuteAgo, StartedAt: &hourAgo, LastDuration: &duration},
+			params:    &models.HeartbeatParams{Period: pointer.ToInt(1000), Grace: pointer.ToInt(0)},
+			expectedTile: &coreModels.Tile{
+				Status:  coreModels.FailedStatus,
+				Message: "no ping for 1m0s",
+				Value:   value,
+				Build:   &coreModels.TileBuild{FinishedAt: &minuteAgo},
+			},
+		},
+		{
+			heartbeat: &models.Heartbeat{LastPingAt: &hourAgo, StartedAt: &minuteAgo, LastDuration: &duration},
+			params:    &models.HeartbeatParams{Period: pointer.ToInt(3600000)},
+			expectedTile: &coreModels.Tile{
+				Status: coreModels.RunningStatus,
+				Value:  value,
+				Build: &coreModels.TileBuild{
+					PreviousStatus:    coreModels.SuccessStatus,
+					StartedAt:         &minuteAgo,
+					EstimatedDuration: &estimatedDuration,
+				},
+			},
+		},
+		{
+			heartbeat: &models.Heartbeat{LastPingAt: &hourAgo},
+			params:    &models.HeartbeatParams{Cron: "* * * * *"},
+			expectedTile: &coreModels.Tile{
+				Status:  coreModels.FailedStatus,
+				Message: "no ping for 1h0m0s",
+				Build:   &coreModels.TileBuild{FinishedAt: &hourAgo},
+			},
+		},
+		{
+			heartbeat: &models.Heartbeat{LastPingAt: &hourAgo},
+			params:    &models.HeartbeatParams{Cron: "* * * * *", Grace: pointer.ToInt(7200000)},
+			expectedTile: &coreModels.Tile{
+				Status: coreModels.SuccessStatus,
+				Build:  &coreModels.TileBuild{FinishedAt: &hourAgo},
+			},
+		},
+	} {
+		mockRepository := new(mocks.Repository)
+		mockRepository.On("GetHeartbeat", "backup").Return(testcase.heartbeat, nil)
+		usecase := NewHeartbeatUsecase(mockRepository, 60000)
+
+		testcase.params.Key = "backup"
+		tile, err := usecase.Heartbeat(testcase.params)
+		if assert.NoError(t, err) {
+			testcase.expectedTile.Type = api.HeartbeatTileType
+			testcase.expectedTile.Label = "backup"
+			assert.Equal(t, testcase.expectedTile, tile)
+			mockRepository.AssertExpectations(t)
+		}
+	}
+}
+
+func TestUsecase_Heartbeat_Error(t *testing.T) {
+	mockRepository := new(mocks.Repository)
+	mockRepository.On("GetHeartbeat", Anything).Return(nil, errors.New("boom"))
+	usecase := NewHeartbeatUsecase(mockRepository, 0)
+
+	tile, err := usecase.Heartbeat(&models.HeartbeatParams{Key: "backup", Period: pointer.ToInt(1000)})
+	if assert.Error(t, err) {
+		assert.Nil(t, tile)
+		assert.IsType(t, &coreModels.MonitororError{}, err)
+	}
+}
+
+func TestUsecase_StartAndPing(t *testing.T) {
+	heartbeat := &models.Heartbeat{}
+
+	mockRepository := new(mocks.Repository)
+	mockRepository.On("GetHeartbeat", "backup").Return(heartbeat, nil)
+	mockRepository.On("SetHeartbeat", "backup", heartbeat).Return(nil)
+	usecase := NewHeartbeatUsecase(mockRepository, 0)
+
+	// Ping without start
+	if assert.NoError(t, usecase.Ping("backup")) {
+		assert.NotNil(t, heartbeat.LastPingAt)
+		assert.Nil(t, heartbeat.LastDuration)
+	}
+
+	// Start, then ping
+	if assert.NoError(t, usecase.Start("backup")) {
+		assert.True(t, heartbeat.IsRunning())
+	}
+	if assert.NoError(t, usecase.Ping("backup")) {
+		assert.False(t, heartbeat.IsRunning())
+		assert.NotNil(t, heartbeat.LastDuration)
+	}
+
+	mockRepository.AssertNumberOfCalls(t, "SetHeartbeat", 3)
+}
+
+func TestUsecase_Ping_Error(t *testing.T) {
+	mockRepository := new(mocks.Repository)
+	mockRepository.On("GetHeartbeat", Anything).Return(nil, nil)
+	mockRepository.On("SetHeartbeat", Anything, AnythingOfType("*models.Heartbeat")).Return(errors.New("boom"))
+	usecase := NewHeartbeatUsecase(mockRepository, 0)
+
+	assert.Error(t, usecase.Ping("backup"))
+	assert.Error(t, usecase.Start("backup"))
+}
@@ -0,0 +1,17 @@
+package config
+
+type (
+	Heartbeat struct {
+		Token string // Shared token, sent by scheduled jobs as Bearer token
+		Grace int    `validate:"gte=0"` // In Millisecond, delay added to the expected period before failing
+		// StoreDir is the directory where heartbeats are written to survive restarts. Kept in memory when empty
+		StoreDir string
+	}
+)
+
+var Default = &Heartbeat{
+	Token: "",
+	Grace: 60000,
+
+	StoreDir: "",
+}
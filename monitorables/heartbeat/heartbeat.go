@@ -0,0 +1,100 @@
+//+build !faker
+
+package heartbeat
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/monitoror/monitoror/api/config/versions"
+	pkgMonitorable "github.com/monitoror/monitoror/internal/pkg/monitorable"
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/heartbeat/api"
+	heartbeatDelivery "github.com/monitoror/monitoror/monitorables/heartbeat/api/delivery/http"
+	heartbeatModels "github.com/monitoror/monitoror/monitorables/heartbeat/api/models"
+	heartbeatRepository "github.com/monitoror/monitoror/monitorables/heartbeat/api/repository"
+	heartbeatUsecase "github.com/monitoror/monitoror/monitorables/heartbeat/api/usecase"
+	heartbeatConfig "github.com/monitoror/monitoror/monitorables/heartbeat/config"
+	"github.com/monitoror/monitoror/registry"
+	"github.com/monitoror/monitoror/service/options"
+	"github.com/monitoror/monitoror/store"
+)
+
+type Monitorable struct {
+	store *store.Store
+
+	config map[coreModels.VariantName]*heartbeatConfig.Heartbeat
+
+	// Config tile settings
+	heartbeatTileEnabler registry.TileEnabler
+}
+
+func NewMonitorable(store *store.Store) *Monitorable {
+	m := &Monitorable{}
+	m.store = store
+	m.config = make(map[coreModels.VariantName]*heartbeatConfig.Heartbeat)
+
+	// Load core config from env
+	pkgMonitorable.LoadConfig(&m.config, heartbeatConfig.Default)
+
+	// Register Monitorable Tile in config manager
+	m.heartbeatTileEnabler = store.Registry.RegisterTile(api.HeartbeatTileType, versions.MinimalVersion, m.GetVariantsNames())
+
+	return m
+}
+
+func (m *Monitorable) GetDisplayName() string {
+	return "Heartbeat"
+}
+
+func (m *Monitorable) GetVariantsNames() []coreModels.VariantName {
+	return pkgMonitorable.GetVariantsNames(m.config)
+}
+
+func (m *Monitorable) Validate(variantName coreModels.VariantName) (bool, []error) {
+	conf := m.config[variantName]
+
+	// No configuration set
+	if conf.Token == "" {
+		return false, nil
+	}
+
+	// Validate Config
+	if errors := pkgMonitorable.ValidateConfig(conf, variantName); errors != nil {
+		return false, errors
+	}
+
+	if conf.StoreDir != "" {
+		if err := os.MkdirAll(conf.StoreDir, 0700); err != nil {
+			return false, []error{fmt.Errorf("unable to create store directory %q: %w", conf.StoreDir, err)}
+		}
+	}
+
+	return true, nil
+}
+
+func (m *Monitorable) Enable(variantName coreModels.VariantName) {
+	conf := m.config[variantName]
+
+	// Heartbeats are lost on restart without store directory
+	repository := heartbeatRepository.NewHeartbeatRepository(m.store.CacheStore, variantName)
+	if conf.StoreDir != "" {
+		repository = heartbeatRepository.NewFileHeartbeatRepository(conf.StoreDir, variantName)
+	}
+	usecase := heartbeatUsecase.NewHeartbeatUsecase(repository, conf.Grace)
+	delivery := heartbeatDelivery.NewHeartbeatDelivery(usecase, conf.Token)
+
+	// EnableTile route to echo, without cache to show pings immediately
+	routeGroup := m.store.MonitorableRouter.Group("/heartbeat", variantName)
+	route := routeGroup.GET("/heartbeat", delivery.GetHeartbeat, options.WithNoCache())
+
+	// Ping routes used by scheduled jobs, with GET or POST
+	pingPath := fmt.Sprintf("/ping/:%s", heartbeatDelivery.KeyPathParam)
+	routeGroup.GET(pingPath, delivery.Ping, options.WithNoCache())
+	routeGroup.POST(pingPath, delivery.Ping)
+	routeGroup.GET(pingPath+"/start", delivery.Start, options.WithNoCache())
+	routeGroup.POST(pingPath+"/start", delivery.Start)
+
+	// EnableTile data for config hydration
+	m.heartbeatTileEnabler.Enable(variantName, &heartbeatModels.HeartbeatParams{}, route.Path)
+}
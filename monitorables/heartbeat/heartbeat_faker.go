@@ -0,0 +1,58 @@
+//+build faker
+
+package heartbeat
+
+import (
+	"fmt"
+
+	"github.com/monitoror/monitoror/api/config/versions"
+	"github.com/monitoror/monitoror/internal/pkg/monitorable"
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/heartbeat/api"
+	heartbeatDelivery "github.com/monitoror/monitoror/monitorables/heartbeat/api/delivery/http"
+	heartbeatModels "github.com/monitoror/monitoror/monitorables/heartbeat/api/models"
+	heartbeatUsecase "github.com/monitoror/monitoror/monitorables/heartbeat/api/usecase"
+	"github.com/monitoror/monitoror/registry"
+	"github.com/monitoror/monitoror/service/options"
+	"github.com/monitoror/monitoror/store"
+)
+
+type Monitorable struct {
+	monitorable.DefaultMonitorableFaker
+
+	store *store.Store
+
+	// Config tile settings
+	heartbeatTileEnabler registry.TileEnabler
+}
+
+func NewMonitorable(store *store.Store) *Monitorable {
+	m := &Monitorable{}
+	m.store = store
+
+	// Register Monitorable Tile in config manager
+	m.heartbeatTileEnabler = store.Registry.RegisterTile(api.HeartbeatTileType, versions.MinimalVersion, m.GetVariantsNames())
+
+	return m
+}
+
+func (m *Monitorable) GetDisplayName() string { return "Heartbeat" }
+
+func (m *Monitorable) Enable(variantName coreModels.VariantName) {
+	usecase := heartbeatUsecase.NewHeartbeatUsecase()
+	delivery := heartbeatDelivery.NewHeartbeatDelivery(usecase, "faker")
+
+	// EnableTile route to echo
+	routeGroup := m.store.MonitorableRouter.Group("/heartbeat", variantName)
+	route := routeGroup.GET("/heartbeat", delivery.GetHeartbeat, options.WithNoCache())
+
+	// Ping routes used by scheduled jobs
+	pingPath := fmt.Sprintf("/ping/:%s", heartbeatDelivery.KeyPathParam)
+	routeGroup.GET(pingPath, delivery.Ping, options.WithNoCache())
+	routeGroup.POST(pingPath, delivery.Ping)
+	routeGroup.GET(pingPath+"/start", delivery.Start, options.WithNoCache())
+	routeGroup.POST(pingPath+"/start", delivery.Start)
+
+	// EnableTile data for config hydration
+	m.heartbeatTileEnabler.Enable(variantName, &heartbeatModels.HeartbeatParams{}, route.Path)
+}
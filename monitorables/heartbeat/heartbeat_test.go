@@ -0,0 +1,71 @@
+package heartbeat
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMonitorable(t *testing.T) {
+	// init Store
+	store, mockMonitorableHelper := test.InitMockAndStore()
+
+	dir, err := ioutil.TempDir("", "monitoror")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "file")
+	_ = ioutil.WriteFile(file, nil, 0600)
+
+	// init Env
+	_ = os.Setenv("MO_MONITORABLE_HEARTBEAT_TOKEN", "token")
+	// Wrong Grace
+	_ = os.Setenv("MO_MONITORABLE_HEARTBEAT_VARIANT0_TOKEN", "token")
+	_ = os.Setenv("MO_MONITORABLE_HEARTBEAT_VARIANT0_GRACE", "-1")
+	// Not configured
+	_ = os.Setenv("MO_MONITORABLE_HEARTBEAT_VARIANT1_GRACE", "1000")
+	// Store directory
+	_ = os.Setenv("MO_MONITORABLE_HEARTBEAT_VARIANT2_TOKEN", "token")
+	_ = os.Setenv("MO_MONITORABLE_HEARTBEAT_VARIANT2_STOREDIR", filepath.Join(dir, "heartbeats"))
+	// Wrong store directory
+	_ = os.Setenv("MO_MONITORABLE_HEARTBEAT_VARIANT3_TOKEN", "token")
+	_ = os.Setenv("MO_MONITORABLE_HEARTBEAT_VARIANT3_STOREDIR", filepath.Join(file, "heartbeats"))
+
+	// NewMonitorable
+	monitorable := NewMonitorable(store)
+	assert.NotNil(t, monitorable)
+
+	// GetDisplayName
+	assert.NotNil(t, monitorable.GetDisplayName())
+
+	// GetVariantsNames and check
+	if assert.Len(t, monitorable.GetVariantsNames(), 5) {
+		_, errors := monitorable.Validate("variant0")
+		assert.NotEmpty(t, errors)
+		valid, errors := monitorable.Validate("variant1")
+		assert.False(t, valid)
+		assert.Empty(t, errors)
+		valid, errors = monitorable.Validate("variant2")
+		assert.True(t, valid)
+		assert.Empty(t, errors)
+		_, errors = monitorable.Validate("variant3")
+		assert.NotEmpty(t, errors)
+	}
+
+	// Enable
+	for _, variantName := range monitorable.GetVariantsNames() {
+		if valid, _ := monitorable.Validate(variantName); valid {
+			monitorable.Enable(variantName)
+		}
+	}
+
+	// Test calls
+	mockMonitorableHelper.RouterAssertNumberOfCalls(t, 2, 6)
+	mockMonitorableHelper.TileSettingsManagerAssertNumberOfCalls(t, 1, 0, 2, 0)
+}
@@ -87,6 +87,22 @@ func TestQueryParams_HTTPFormattedParams(t *testing.T) {
 	assert.NoError(t, handler.GetHTTPFormatted(ctx))
 }
 
+func TestQueryParams_HTTPLatencyParams(t *testing.T) {
+	ctx, _ := initEcho()
+	ctx.QueryParams().Set("url", "http://monitoror.example.com")
+	ctx.QueryParams().Set("warningLatency", "200")
+	ctx.QueryParams().Set("failureLatency", "1000")
+
+	mockUsecase := new(mocks.Usecase)
+	mockUsecase.On("HTTPLatency", &models.HTTPLatencyParams{
+		URL:            "http://monitoror.example.com",
+		WarningLatency: pointer.ToInt(200),
+		FailureLatency: pointer.ToInt(1000),
+	}).Return(nil, nil)
+	handler := NewHTTPDelivery(mockUsecase)
+	assert.NoError(t, handler.GetHTTPLatency(ctx))
+}
+
 func Test_httpHttpDelivery_GetHttp_MissingParams(t *testing.T) {
 	// init tests cases
 	testcases := []handlerFunc{
@@ -99,6 +115,9 @@ func Test_httpHttpDelivery_GetHttp_MissingParams(t *testing.T) {
 		func(handler *HTTPDelivery) func(ctx echo.Context) error {
 			return handler.GetHTTPFormatted
 		},
+		func(handler *HTTPDelivery) func(ctx echo.Context) error {
+			return handler.GetHTTPLatency
+		},
 	}
 
 	// tests
@@ -137,6 +156,12 @@ func Test_httpHttpDelivery_GetHttp_Error(t *testing.T) {
 				return handler.GetHTTPFormatted
 			},
 		},
+		{
+			mockFuncName: "HTTPLatency",
+			handlerFunc: func(handler *HTTPDelivery) func(ctx echo.Context) error {
+				return handler.GetHTTPLatency
+			},
+		},
 	}
 
 	// tests
@@ -187,6 +212,13 @@ func Test_httpHttpDelivery_GetHttp(t *testing.T) {
 				return handler.GetHTTPFormatted
 			},
 		},
+		{
+			tileType:     api.HTTPLatencyTileType,
+			mockFuncName: "HTTPLatency",
+			handlerFunc: func(handler *HTTPDelivery) func(ctx echo.Context) error {
+				return handler.GetHTTPLatency
+			},
+		},
 	}
 
 	// tests
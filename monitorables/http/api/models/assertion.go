@@ -0,0 +1,131 @@
+package models
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+
+	coreModels "github.com/monitoror/monitoror/models"
+)
+
+type (
+	// Assertion is a check on the response, written like this: "[WARNING|FAILURE] <subject> <operator> <value>"
+	// ex: "status == 200", "WARNING header:Content-Type contains json", "json:queue.size < 100"
+	Assertion struct {
+		Raw string
+
+		Severity coreModels.TileStatus
+		Subject  AssertionSubject
+		Name     string // Header name or key for HeaderSubject and JSONSubject
+		Operator AssertionOperator
+		Value    string
+	}
+
+	AssertionSubject  string
+	AssertionOperator string
+)
+
+const (
+	StatusSubject          AssertionSubject = "status"
+	SizeSubject            AssertionSubject = "size"
+	CertificateDaysSubject AssertionSubject = "certificateDays"
+	BodySubject            AssertionSubject = "body"
+	HeaderSubject          AssertionSubject = "header"
+	JSONSubject            AssertionSubject = "json"
+)
+
+const (
+	EqualOperator          AssertionOperator = "=="
+	NotEqualOperator       AssertionOperator = "!="
+	LowerOperator          AssertionOperator = "<"
+	LowerOrEqualOperator   AssertionOperator = "<="
+	GreaterOperator        AssertionOperator = ">"
+	GreaterOrEqualOperator AssertionOperator = ">="
+	BetweenOperator        AssertionOperator = "between"
+	ContainsOperator       AssertionOperator = "contains"
+	MatchesOperator        AssertionOperator = "matches"
+)
+
+var assertionRegex = regexp.MustCompile(
+	"^(?:(WARNING|FAILURE)\\s+)?" +
+		"(status|size|certificateDays|body|header:[a-zA-Z0-9!#$%&'*+.^_`|~-]+|json:\\S+)\\s+" +
+		"(==|!=|<=|>=|<|>|between|contains|matches)\\s+" +
+		"(.*)$",
+)
+
+var errInvalidAssertion = errors.New(`"[WARNING|FAILURE] <subject> <operator> <value>" (ex: "status == 200")`)
+
+// ParseAssertion parse assertion and check if operator and value are valid for subject
+func ParseAssertion(raw string) (*Assertion, error) {
+	substrings := assertionRegex.FindStringSubmatch(strings.TrimSpace(raw))
+	if substrings == nil {
+		return nil, errInvalidAssertion
+	}
+
+	assertion := &Assertion{
+		Raw:      raw,
+		Severity: coreModels.FailedStatus,
+		Subject:  AssertionSubject(substrings[2]),
+		Operator: AssertionOperator(substrings[3]),
+		Value:    substrings[4],
+	}
+	if substrings[1] == "WARNING" {
+		assertion.Severity = coreModels.WarningStatus
+	}
+	if i := strings.Index(substrings[2], ":"); i != -1 {
+		assertion.Subject = AssertionSubject(substrings[2][:i])
+		assertion.Name = substrings[2][i+1:]
+	}
+
+	switch assertion.Operator {
+	case LowerOperator, LowerOrEqualOperator, GreaterOperator, GreaterOrEqualOperator:
+		if _, err := strconv.ParseFloat(assertion.Value, 64); err != nil {
+			return nil, errors.New("a number after " + string(assertion.Operator))
+		}
+	case BetweenOperator:
+		if _, _, ok := assertion.Bounds(); !ok {
+			return nil, errors.New(`two numbers after between (ex: "status between 200 299")`)
+		}
+	case ContainsOperator, MatchesOperator:
+		if assertion.IsNumeric() {
+			return nil, errors.New("a comparison operator for " + string(assertion.Subject))
+		}
+		if assertion.Operator == MatchesOperator {
+			if _, err := regexp.Compile(assertion.Value); err != nil {
+				return nil, errors.New("a valid regex after matches")
+			}
+		}
+	}
+
+	if assertion.IsNumeric() && (assertion.Operator == EqualOperator || assertion.Operator == NotEqualOperator) {
+		if _, err := strconv.ParseFloat(assertion.Value, 64); err != nil {
+			return nil, errors.New("a number for " + string(assertion.Subject))
+		}
+	}
+
+	return assertion, nil
+}
+
+// IsNumeric return true when subject is always a number
+func (a *Assertion) IsNumeric() bool {
+	return a.Subject == StatusSubject || a.Subject == SizeSubject || a.Subject == CertificateDaysSubject
+}
+
+// Bounds return min and max of between operator
+func (a *Assertion) Bounds() (min float64, max float64, ok bool) {
+	fields := strings.Fields(a.Value)
+	if len(fields) != 2 {
+		return
+	}
+
+	var err error
+	if min, err = strconv.ParseFloat(fields[0], 64); err != nil {
+		return
+	}
+	if max, err = strconv.ParseFloat(fields[1], 64); err != nil {
+		return
+	}
+
+	return min, max, min <= max
+}
@@ -0,0 +1,56 @@
+package models
+
+import (
+	"testing"
+
+	coreModels "github.com/monitoror/monitoror/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAssertion(t *testing.T) {
+	for _, testcase := range []struct {
+		raw               string
+		expectedError     bool
+		expectedAssertion *Assertion
+	}{
+		{
+			raw:               "status == 200",
+			expectedAssertion: &Assertion{Raw: "status == 200", Severity: coreModels.FailedStatus, Subject: StatusSubject, Operator: EqualOperator, Value: "200"},
+		},
+		{
+			raw:               "WARNING header:Content-Type contains application/json",
+			expectedAssertion: &Assertion{Raw: "WARNING header:Content-Type contains application/json", Severity: coreModels.WarningStatus, Subject: HeaderSubject, Name: "Content-Type", Operator: ContainsOperator, Value: "application/json"},
+		},
+		{
+			raw:               "FAILURE json:queue.\"high.priority\".size > 100",
+			expectedAssertion: &Assertion{Raw: "FAILURE json:queue.\"high.priority\".size > 100", Severity: coreModels.FailedStatus, Subject: JSONSubject, Name: "queue.\"high.priority\".size", Operator: GreaterOperator, Value: "100"},
+		},
+		{
+			raw:               "body matches ^status: (OK|UP)$",
+			expectedAssertion: &Assertion{Raw: "body matches ^status: (OK|UP)$", Severity: coreModels.FailedStatus, Subject: BodySubject, Operator: MatchesOperator, Value: "^status: (OK|UP)$"},
+		},
+		{
+			raw:               "status between 200 299",
+			expectedAssertion: &Assertion{Raw: "status between 200 299", Severity: coreModels.FailedStatus, Subject: StatusSubject, Operator: BetweenOperator, Value: "200 299"},
+		},
+		{raw: "certificateDays >= 14", expectedAssertion: &Assertion{Raw: "certificateDays >= 14", Severity: coreModels.FailedStatus, Subject: CertificateDaysSubject, Operator: GreaterOrEqualOperator, Value: "14"}},
+		{raw: "size < 1kb", expectedError: true},
+		{raw: "status == OK", expectedError: true},
+		{raw: "status contains 20", expectedError: true},
+		{raw: "status between 299 200", expectedError: true},
+		{raw: "status between 200", expectedError: true},
+		{raw: "body matches (", expectedError: true},
+		{raw: "header: == value", expectedError: true},
+		{raw: "cookie:session == 1", expectedError: true},
+		{raw: "INFO status == 200", expectedError: true},
+		{raw: "status", expectedError: true},
+	} {
+		assertion, err := ParseAssertion(testcase.raw)
+		if testcase.expectedError {
+			assert.Error(t, err, testcase.raw)
+		} else if assert.NoError(t, err, testcase.raw) {
+			assert.Equal(t, testcase.expectedAssertion, assertion)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+package models
+
+import (
+	"fmt"
+)
+
+// TokenError is returned by Repository.Do when OAuth2 access token can't be fetched
+type TokenError struct {
+	TokenURL string
+	Err      error
+}
+
+func (e *TokenError) Error() string {
+	return fmt.Sprintf("unable to get oauth2 token from %s: %v", e.TokenURL, e.Err)
+}
+
+func (e *TokenError) Unwrap() error { return e.Err }
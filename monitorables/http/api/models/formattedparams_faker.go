@@ -11,12 +11,27 @@ import (
 
 type (
 	HTTPFormattedParams struct {
-		URL           string `json:"url" query:"url" validate:"required,url,http"`
-		Format        Format `json:"format" query:"format" validate:"required,oneof=JSON YAML XML"`
-		Key           string `json:"key" query:"key" validate:"required,ne=."`
-		Regex         string `json:"regex,omitempty" query:"regex" validate:"regex"`
-		StatusCodeMin *int   `json:"statusCodeMin,omitempty" query:"statusCodeMin"`
-		StatusCodeMax *int   `json:"statusCodeMax,omitempty" query:"statusCodeMax"`
+		URL           string        `json:"url" query:"url" validate:"required,url,http"`
+		Format        Format        `json:"format" query:"format" validate:"required,oneof=JSON YAML XML HTML CSV"`
+		Key           string        `json:"key" query:"key" validate:"required,ne=."`
+		QueryLanguage QueryLanguage `json:"queryLanguage,omitempty" query:"queryLanguage" validate:"omitempty,oneof=JSONPATH JMESPATH XPATH"`
+		Row           *int          `json:"row,omitempty" query:"row" validate:"omitempty,gte=0"`
+		Aggregate     Aggregate     `json:"aggregate,omitempty" query:"aggregate" validate:"omitempty,oneof=FIRST LAST COUNT SUM AVERAGE MIN MAX"`
+		Regex         string        `json:"regex,omitempty" query:"regex" validate:"regex"`
+		StatusCodeMin *int          `json:"statusCodeMin,omitempty" query:"statusCodeMin"`
+		StatusCodeMax *int          `json:"statusCodeMax,omitempty" query:"statusCodeMax"`
+
+		Method  string   `json:"method,omitempty" query:"method" validate:"omitempty,oneof=GET POST PUT PATCH DELETE HEAD OPTIONS"`
+		Headers []string `json:"headers,omitempty" query:"headers"`
+		Body    string   `json:"body,omitempty" query:"body"`
+
+		Assertions []string `json:"assertions,omitempty" query:"assertions"`
+
+		Unit      coreModels.TileValuesUnit `json:"unit,omitempty" query:"unit" validate:"omitempty,oneof=MILLISECOND SECOND RATIO PERCENT NUMBER BYTES BINARY_BYTES CURRENCY PER_SECOND PER_MINUTE RAW"`
+		Precision *int                      `json:"precision,omitempty" query:"precision" validate:"omitempty,gte=0,lte=10"`
+		Prefix    string                    `json:"prefix,omitempty" query:"prefix"`
+		Suffix    string                    `json:"suffix,omitempty" query:"suffix"`
+		Currency  string                    `json:"currency,omitempty" query:"currency"`
 
 		Status      coreModels.TileStatus     `json:"status" query:"status"`
 		Message     string                    `json:"message" query:"message"`
@@ -26,7 +41,13 @@ type (
 )
 
 func (p *HTTPFormattedParams) Validate() []validator.Error {
-	return validateStatusCode(p)
+	errors := validateStatusCode(p)
+	errors = append(errors, validateHeaders(p.Headers)...)
+	errors = append(errors, validateAssertions(p.Assertions)...)
+	errors = append(errors, validateValueFormat(p)...)
+	errors = append(errors, validateQuery(p)...)
+	errors = append(errors, validateSelection(p)...)
+	return errors
 }
 
 func (p *HTTPFormattedParams) GetURL() (url string) { return p.URL }
@@ -34,11 +55,26 @@ func (p *HTTPFormattedParams) GetStatusCodes() (min int, max int) {
 	return getStatusCodesWithDefault(p.StatusCodeMin, p.StatusCodeMax)
 }
 
+func (p *HTTPFormattedParams) GetMethod() string             { return getMethodWithDefault(p.Method) }
+func (p *HTTPFormattedParams) GetHeaders() map[string]string { return getHeaders(p.Headers) }
+func (p *HTTPFormattedParams) GetBody() string               { return p.Body }
+
+func (p *HTTPFormattedParams) GetAssertions() []*Assertion { return getAssertions(p.Assertions) }
+
 func (p *HTTPFormattedParams) GetRegex() string          { return p.Regex }
 func (p *HTTPFormattedParams) GetRegexp() *regexp.Regexp { return getRegexp(p.GetRegex()) }
 
-func (p *HTTPFormattedParams) GetKey() string    { return p.Key }
-func (p *HTTPFormattedParams) GetFormat() Format { return p.Format }
+func (p *HTTPFormattedParams) GetUnit() coreModels.TileValuesUnit { return p.Unit }
+func (p *HTTPFormattedParams) GetPrecision() *int                 { return p.Precision }
+func (p *HTTPFormattedParams) GetPrefix() string                  { return p.Prefix }
+func (p *HTTPFormattedParams) GetSuffix() string                  { return p.Suffix }
+func (p *HTTPFormattedParams) GetCurrency() string                { return p.Currency }
+
+func (p *HTTPFormattedParams) GetKey() string                  { return p.Key }
+func (p *HTTPFormattedParams) GetFormat() Format               { return p.Format }
+func (p *HTTPFormattedParams) GetQueryLanguage() QueryLanguage { return p.QueryLanguage }
+func (p *HTTPFormattedParams) GetRow() *int                    { return p.Row }
+func (p *HTTPFormattedParams) GetAggregate() Aggregate         { return p.Aggregate }
 
 func (p *HTTPFormattedParams) GetStatus() coreModels.TileStatus        { return p.Status }
 func (p *HTTPFormattedParams) GetMessage() string                      { return p.Message }
@@ -0,0 +1,59 @@
+//+build faker
+
+package models
+
+import (
+	"github.com/monitoror/monitoror/internal/pkg/validator"
+	coreModels "github.com/monitoror/monitoror/models"
+)
+
+type (
+	HTTPLatencyParams struct {
+		URL           string `json:"url" query:"url" validate:"required,url,http"`
+		StatusCodeMin *int   `json:"statusCodeMin,omitempty" query:"statusCodeMin"`
+		StatusCodeMax *int   `json:"statusCodeMax,omitempty" query:"statusCodeMax"`
+
+		Method  string   `json:"method,omitempty" query:"method" validate:"omitempty,oneof=GET POST PUT PATCH DELETE HEAD OPTIONS"`
+		Headers []string `json:"headers,omitempty" query:"headers"`
+		Body    string   `json:"body,omitempty" query:"body"`
+
+		Assertions []string `json:"assertions,omitempty" query:"assertions"`
+
+		WarningLatency *int `json:"warningLatency,omitempty" query:"warningLatency" validate:"omitempty,gt=0"`
+		FailureLatency *int `json:"failureLatency,omitempty" query:"failureLatency" validate:"omitempty,gt=0"`
+
+		Status      coreModels.TileStatus `json:"status" query:"status"`
+		Message     string                `json:"message" query:"message"`
+		ValueValues []string              `json:"valueValues" query:"valueValues"`
+	}
+)
+
+func (p *HTTPLatencyParams) Validate() []validator.Error {
+	errors := validateStatusCode(p)
+	errors = append(errors, validateHeaders(p.Headers)...)
+	errors = append(errors, validateAssertions(p.Assertions)...)
+	errors = append(errors, validateLatencies(p)...)
+	return errors
+}
+
+func (p *HTTPLatencyParams) GetURL() (url string) { return p.URL }
+func (p *HTTPLatencyParams) GetStatusCodes() (min int, max int) {
+	return getStatusCodesWithDefault(p.StatusCodeMin, p.StatusCodeMax)
+}
+
+func (p *HTTPLatencyParams) GetMethod() string             { return getMethodWithDefault(p.Method) }
+func (p *HTTPLatencyParams) GetHeaders() map[string]string { return getHeaders(p.Headers) }
+func (p *HTTPLatencyParams) GetBody() string               { return p.Body }
+
+func (p *HTTPLatencyParams) GetAssertions() []*Assertion { return getAssertions(p.Assertions) }
+
+func (p *HTTPLatencyParams) GetLatencies() (warning *int, failure *int) {
+	return p.WarningLatency, p.FailureLatency
+}
+
+func (p *HTTPLatencyParams) GetStatus() coreModels.TileStatus { return p.Status }
+func (p *HTTPLatencyParams) GetMessage() string               { return p.Message }
+func (p *HTTPLatencyParams) GetValueValues() []string         { return p.ValueValues }
+func (p *HTTPLatencyParams) GetValueUnit() coreModels.TileValuesUnit {
+	return coreModels.MillisecondUnit
+}
@@ -1,15 +1,23 @@
 package models
 
 import (
+	"fmt"
 	"regexp"
 
 	"github.com/monitoror/monitoror/internal/pkg/validator"
+	coreModels "github.com/monitoror/monitoror/models"
 )
 
 type (
 	GenericParamsProvider interface {
 		GetURL() (url string)
 		GetStatusCodes() (min int, max int)
+
+		GetMethod() string
+		GetHeaders() map[string]string
+		GetBody() string
+
+		GetAssertions() []*Assertion
 	}
 
 	RegexParamsProvider interface {
@@ -20,6 +28,21 @@ type (
 	FormattedParamsProvider interface {
 		GetFormat() Format
 		GetKey() string
+		GetQueryLanguage() QueryLanguage
+		GetRow() *int
+		GetAggregate() Aggregate
+	}
+
+	LatencyParamsProvider interface {
+		GetLatencies() (warning *int, failure *int)
+	}
+
+	ValueFormatParamsProvider interface {
+		GetUnit() coreModels.TileValuesUnit
+		GetPrecision() *int
+		GetPrefix() string
+		GetSuffix() string
+		GetCurrency() string
 	}
 
 	Format string
@@ -30,10 +53,19 @@ const (
 	DefaultMaxStatusCode = 399
 )
 
+const DefaultMethod = "GET"
+
 const (
 	JSONFormat Format = "JSON"
 	YAMLFormat Format = "YAML"
 	XMLFormat  Format = "XML"
+	HTMLFormat Format = "HTML"
+	CSVFormat  Format = "CSV"
+)
+
+var (
+	currencyRegex = regexp.MustCompile(`^[A-Z]{3}$`)
+	headerRegex   = regexp.MustCompile("^([a-zA-Z0-9!#$%&'*+.^_`|~-]+):\\s*(.*)$")
 )
 
 func validateStatusCode(params GenericParamsProvider) []validator.Error {
@@ -44,6 +76,118 @@ func validateStatusCode(params GenericParamsProvider) []validator.Error {
 	return nil
 }
 
+func validateValueFormat(params ValueFormatParamsProvider) []validator.Error {
+	if params.GetUnit() == coreModels.CurrencyUnit && params.GetCurrency() == "" {
+		return []validator.Error{validator.NewDefaultError("Currency", "currency is required with CURRENCY unit")}
+	}
+	if currency := params.GetCurrency(); currency != "" && !currencyRegex.MatchString(currency) {
+		return []validator.Error{validator.NewDefaultError("Currency", "ISO 4217 currency code (ex: EUR, USD)")}
+	}
+
+	return nil
+}
+
+func validateQuery(params FormattedParamsProvider) []validator.Error {
+	language := params.GetQueryLanguage()
+	if language == "" {
+		return nil
+	}
+
+	format := params.GetFormat()
+	if language == XPathQueryLanguage && format != XMLFormat ||
+		language != XPathQueryLanguage && format != JSONFormat && format != YAMLFormat {
+		return []validator.Error{validator.NewDefaultError("QueryLanguage", "XPATH with XML format, JSONPATH or JMESPATH with JSON and YAML formats")}
+	}
+
+	if err := compileQuery(language, params.GetKey()); err != nil {
+		return []validator.Error{validator.NewDefaultError("Key", fmt.Sprintf("valid %s query", language))}
+	}
+
+	return nil
+}
+
+func validateSelection(params FormattedParamsProvider) []validator.Error {
+	format := params.GetFormat()
+	if params.GetRow() != nil && format != CSVFormat {
+		return []validator.Error{validator.NewDefaultError("Row", "row with CSV format")}
+	}
+	if params.GetAggregate() != "" && format != HTMLFormat && format != CSVFormat {
+		return []validator.Error{validator.NewDefaultError("Aggregate", "aggregate with HTML or CSV formats")}
+	}
+	if params.GetRow() != nil && params.GetAggregate() != "" {
+		return []validator.Error{validator.NewDefaultError("Row", "row or aggregate, not both")}
+	}
+
+	if format == HTMLFormat {
+		if _, err := NewCSSSelector(params.GetKey()); err != nil {
+			return []validator.Error{validator.NewDefaultError("Key", "valid CSS selector")}
+		}
+	}
+
+	return nil
+}
+
+func validateLatencies(params LatencyParamsProvider) []validator.Error {
+	if warning, failure := params.GetLatencies(); warning != nil && failure != nil && *warning > *failure {
+		return []validator.Error{validator.NewDefaultError("WarningLatency", "warningLatency <= failureLatency")}
+	}
+
+	return nil
+}
+
+func validateHeaders(headers []string) []validator.Error {
+	var errors []validator.Error
+	for i, header := range headers {
+		if !headerRegex.MatchString(header) {
+			errors = append(errors, validator.NewDefaultError(fmt.Sprintf("Headers[%d]", i), `"Name: value"`))
+		}
+	}
+
+	return errors
+}
+
+func validateAssertions(assertions []string) []validator.Error {
+	var errors []validator.Error
+	for i, assertion := range assertions {
+		if _, err := ParseAssertion(assertion); err != nil {
+			errors = append(errors, validator.NewDefaultError(fmt.Sprintf("Assertions[%d]", i), err.Error()))
+		}
+	}
+
+	return errors
+}
+
+func getMethodWithDefault(method string) string {
+	if method == "" {
+		return DefaultMethod
+	}
+	return method
+}
+
+func getHeaders(headers []string) map[string]string {
+	if len(headers) == 0 {
+		return nil
+	}
+
+	result := make(map[string]string)
+	for _, header := range headers {
+		if substrings := headerRegex.FindStringSubmatch(header); substrings != nil { // Already validate by validateHeaders
+			result[substrings[1]] = substrings[2]
+		}
+	}
+	return result
+}
+
+func getAssertions(assertions []string) []*Assertion {
+	var result []*Assertion
+	for _, assertion := range assertions {
+		if parsedAssertion, err := ParseAssertion(assertion); err == nil { // Already validate by validateAssertions
+			result = append(result, parsedAssertion)
+		}
+	}
+	return result
+}
+
 func getStatusCodesWithDefault(statusCodeMin, statusCodeMax *int) (min int, max int) {
 	min = DefaultMinStatusCode
 	if statusCodeMin != nil {
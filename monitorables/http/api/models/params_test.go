@@ -6,6 +6,7 @@ import (
 
 	"github.com/monitoror/monitoror/internal/pkg/monitorable/params"
 	"github.com/monitoror/monitoror/internal/pkg/monitorable/test"
+	coreModels "github.com/monitoror/monitoror/models"
 
 	"github.com/AlekSi/pointer"
 	"github.com/stretchr/testify/assert"
@@ -22,6 +23,11 @@ func TestHTTPParams(t *testing.T) {
 		{&HTTPStatusParams{URL: "http://example.com"}, 0},
 		{&HTTPStatusParams{URL: "http://example.com", StatusCodeMin: pointer.ToInt(300), StatusCodeMax: pointer.ToInt(299)}, 1},
 		{&HTTPStatusParams{URL: "http://example.com", StatusCodeMin: pointer.ToInt(299), StatusCodeMax: pointer.ToInt(300)}, 0},
+		{&HTTPStatusParams{URL: "http://example.com", Method: "CONNECT"}, 1},
+		{&HTTPStatusParams{URL: "http://example.com", Headers: []string{"X-Api-Version 2", "Bad Name: value"}}, 2},
+		{&HTTPStatusParams{URL: "http://example.com", Method: "POST", Headers: []string{"Content-Type: application/json"}, Body: `{"query": "{ health }"}`}, 0},
+		{&HTTPStatusParams{URL: "http://example.com", Assertions: []string{"status == 200", "WARNING certificateDays > 14"}}, 0},
+		{&HTTPStatusParams{URL: "http://example.com", Assertions: []string{"status is 200", "size < big"}}, 2},
 
 		{&HTTPRawParams{}, 1},
 		{&HTTPRawParams{URL: "http://example.com"}, 0},
@@ -29,6 +35,14 @@ func TestHTTPParams(t *testing.T) {
 		{&HTTPRawParams{URL: "http://example.com", StatusCodeMin: pointer.ToInt(299), StatusCodeMax: pointer.ToInt(300)}, 0},
 		{&HTTPRawParams{URL: "http://example.com", Regex: "("}, 1},
 		{&HTTPRawParams{URL: "http://example.com", Regex: "(.*)"}, 0},
+		{&HTTPRawParams{URL: "http://example.com", Method: "HEAD", Headers: []string{"Accept:text/plain"}}, 0},
+		{&HTTPRawParams{URL: "http://example.com", Headers: []string{":"}}, 1},
+		{&HTTPRawParams{URL: "http://example.com", Unit: "unknown"}, 1},
+		{&HTTPRawParams{URL: "http://example.com", Unit: coreModels.BytesUnit, Precision: pointer.ToInt(2)}, 0},
+		{&HTTPRawParams{URL: "http://example.com", Precision: pointer.ToInt(11)}, 1},
+		{&HTTPRawParams{URL: "http://example.com", Unit: coreModels.CurrencyUnit}, 1},
+		{&HTTPRawParams{URL: "http://example.com", Unit: coreModels.CurrencyUnit, Currency: "EURO"}, 1},
+		{&HTTPRawParams{URL: "http://example.com", Unit: coreModels.CurrencyUnit, Currency: "EUR"}, 0},
 
 		{&HTTPFormattedParams{}, 3},
 		{&HTTPFormattedParams{URL: "http://example.com"}, 2},
@@ -40,6 +54,39 @@ func TestHTTPParams(t *testing.T) {
 		{&HTTPFormattedParams{URL: "http://example.com", Format: "JSON", Key: "key", StatusCodeMin: pointer.ToInt(299), StatusCodeMax: pointer.ToInt(300)}, 0},
 		{&HTTPFormattedParams{URL: "http://example.com", Format: "JSON", Key: "key", Regex: "("}, 1},
 		{&HTTPFormattedParams{URL: "http://example.com", Format: "JSON", Key: "key", Regex: "(.*)"}, 0},
+		{&HTTPFormattedParams{URL: "http://example.com", Format: "JSON", Key: "key", Method: "post"}, 1},
+		{&HTTPFormattedParams{URL: "http://example.com", Format: "JSON", Key: "key", Method: "PUT", Body: "{}"}, 0},
+		{&HTTPFormattedParams{URL: "http://example.com", Format: "JSON", Key: "key", Unit: coreModels.PercentUnit, Suffix: " used"}, 0},
+		{&HTTPFormattedParams{URL: "http://example.com", Format: "JSON", Key: "key", Unit: coreModels.CurrencyUnit}, 1},
+		{&HTTPFormattedParams{URL: "http://example.com", Format: "JSON", Key: "$.nodes[?(@.healthy == false)]", QueryLanguage: JSONPathQueryLanguage}, 0},
+		{&HTTPFormattedParams{URL: "http://example.com", Format: "YAML", Key: "length(nodes[?healthy == `false`])", QueryLanguage: JMESPathQueryLanguage}, 0},
+		{&HTTPFormattedParams{URL: "http://example.com", Format: "XML", Key: "count(//node[@healthy='false'])", QueryLanguage: XPathQueryLanguage}, 0},
+		{&HTTPFormattedParams{URL: "http://example.com", Format: "JSON", Key: "key", QueryLanguage: "JQ"}, 1},
+		{&HTTPFormattedParams{URL: "http://example.com", Format: "JSON", Key: "/nodes", QueryLanguage: XPathQueryLanguage}, 1},
+		{&HTTPFormattedParams{URL: "http://example.com", Format: "XML", Key: "$.nodes", QueryLanguage: JSONPathQueryLanguage}, 1},
+		{&HTTPFormattedParams{URL: "http://example.com", Format: "JSON", Key: "$.nodes[", QueryLanguage: JSONPathQueryLanguage}, 1},
+		{&HTTPFormattedParams{URL: "http://example.com", Format: "JSON", Key: "nodes[?", QueryLanguage: JMESPathQueryLanguage}, 1},
+		{&HTTPFormattedParams{URL: "http://example.com", Format: "XML", Key: "//node[", QueryLanguage: XPathQueryLanguage}, 1},
+		{&HTTPFormattedParams{URL: "http://example.com", Format: "HTML", Key: "#status .value"}, 0},
+		{&HTTPFormattedParams{URL: "http://example.com", Format: "HTML", Key: "tr.failed", Aggregate: CountAggregate}, 0},
+		{&HTTPFormattedParams{URL: "http://example.com", Format: "HTML", Key: "div["}, 1},
+		{&HTTPFormattedParams{URL: "http://example.com", Format: "HTML", Key: "#status", QueryLanguage: JSONPathQueryLanguage}, 1},
+		{&HTTPFormattedParams{URL: "http://example.com", Format: "HTML", Key: "#status", Row: pointer.ToInt(1)}, 1},
+		{&HTTPFormattedParams{URL: "http://example.com", Format: "CSV", Key: "errors", Row: pointer.ToInt(0)}, 0},
+		{&HTTPFormattedParams{URL: "http://example.com", Format: "CSV", Key: "errors", Aggregate: SumAggregate}, 0},
+		{&HTTPFormattedParams{URL: "http://example.com", Format: "CSV", Key: "errors", Aggregate: "MEDIAN"}, 1},
+		{&HTTPFormattedParams{URL: "http://example.com", Format: "CSV", Key: "errors", Row: pointer.ToInt(-1)}, 1},
+		{&HTTPFormattedParams{URL: "http://example.com", Format: "CSV", Key: "errors", Row: pointer.ToInt(0), Aggregate: LastAggregate}, 1},
+		{&HTTPFormattedParams{URL: "http://example.com", Format: "JSON", Key: "errors", Aggregate: LastAggregate}, 1},
+
+		{&HTTPLatencyParams{}, 1},
+		{&HTTPLatencyParams{URL: "http://example.com"}, 0},
+		{&HTTPLatencyParams{URL: "http://example.com", WarningLatency: pointer.ToInt(0)}, 1},
+		{&HTTPLatencyParams{URL: "http://example.com", WarningLatency: pointer.ToInt(500), FailureLatency: pointer.ToInt(200)}, 1},
+		{&HTTPLatencyParams{URL: "http://example.com", WarningLatency: pointer.ToInt(200), FailureLatency: pointer.ToInt(500)}, 0},
+		{&HTTPLatencyParams{URL: "http://example.com", Method: "HEAD", Headers: []string{"X-Api-Version: 2"}}, 0},
+		{&HTTPLatencyParams{URL: "http://example.com", Assertions: []string{"header:Cache-Control contains no-cache"}}, 0},
+		{&HTTPLatencyParams{URL: "http://example.com", Assertions: []string{"header:Cache-Control"}}, 1},
 	} {
 		test.AssertParams(t, testcase.params, testcase.errorCount)
 		if testcase.errorCount == 0 {
@@ -84,3 +131,22 @@ func TestHTTPFormattedParams_FormattedDataProvider(t *testing.T) {
 		assert.Equal(t, testcase.expectedKey, testcase.params.GetKey())
 	}
 }
+
+func TestHTTPParams_GenericParamsProvider(t *testing.T) {
+	for _, testcase := range []struct {
+		params          GenericParamsProvider
+		expectedMethod  string
+		expectedHeaders map[string]string
+		expectedBody    string
+	}{
+		{&HTTPStatusParams{}, "GET", nil, ""},
+		{&HTTPStatusParams{Method: "HEAD"}, "HEAD", nil, ""},
+		{&HTTPRawParams{Headers: []string{"X-Api-Version: 2", "Accept:text/plain"}}, "GET", map[string]string{"X-Api-Version": "2", "Accept": "text/plain"}, ""},
+		{&HTTPFormattedParams{Method: "POST", Body: `{"query": "{ health }"}`}, "POST", nil, `{"query": "{ health }"}`},
+		{&HTTPLatencyParams{Method: "HEAD"}, "HEAD", nil, ""},
+	} {
+		assert.Equal(t, testcase.expectedMethod, testcase.params.GetMethod())
+		assert.Equal(t, testcase.expectedHeaders, testcase.params.GetHeaders())
+		assert.Equal(t, testcase.expectedBody, testcase.params.GetBody())
+	}
+}
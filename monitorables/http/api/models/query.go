@@ -0,0 +1,80 @@
+package models
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/PaesslerAG/gval"
+	"github.com/PaesslerAG/jsonpath"
+	"github.com/antchfx/xpath"
+	"github.com/jmespath/go-jmespath"
+)
+
+type QueryLanguage string
+
+const (
+	JSONPathQueryLanguage QueryLanguage = "JSONPATH"
+	JMESPathQueryLanguage QueryLanguage = "JMESPATH"
+	XPathQueryLanguage    QueryLanguage = "XPATH"
+)
+
+// jsonPathLanguage is JSONPath with filters expressions and aggregate functions
+var jsonPathLanguage = gval.Full(
+	jsonpath.Language(),
+	gval.Function("length", jsonPathLength),
+	gval.Function("sum", jsonPathSum),
+)
+
+func NewJSONPath(query string) (gval.Evaluable, error) {
+	return jsonPathLanguage.NewEvaluable(query)
+}
+
+func NewJMESPath(query string) (*jmespath.JMESPath, error) {
+	return jmespath.Compile(query)
+}
+
+func NewXPath(query string) (*xpath.Expr, error) {
+	return xpath.Compile(query)
+}
+
+// compileQuery check if query is valid for its language
+func compileQuery(language QueryLanguage, query string) (err error) {
+	switch language {
+	case JSONPathQueryLanguage:
+		_, err = NewJSONPath(query)
+	case JMESPathQueryLanguage:
+		_, err = NewJMESPath(query)
+	case XPathQueryLanguage:
+		_, err = NewXPath(query)
+	}
+	return
+}
+
+// jsonPathLength return length of array, object or string
+func jsonPathLength(value interface{}) (interface{}, error) {
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Slice, reflect.Map, reflect.String:
+		return float64(reflect.ValueOf(value).Len()), nil
+	default:
+		return nil, fmt.Errorf("length() expects an array, an object or a string, got %T", value)
+	}
+}
+
+// jsonPathSum return sum of numbers in array
+func jsonPathSum(value interface{}) (interface{}, error) {
+	array, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("sum() expects an array, got %T", value)
+	}
+
+	sum := float64(0)
+	for _, item := range array {
+		number, ok := item.(float64)
+		if !ok {
+			return nil, fmt.Errorf("sum() expects an array of numbers, got %T in array", item)
+		}
+		sum += number
+	}
+
+	return sum, nil
+}
@@ -6,6 +6,7 @@ import (
 	"regexp"
 
 	"github.com/monitoror/monitoror/internal/pkg/validator"
+	coreModels "github.com/monitoror/monitoror/models"
 )
 
 type (
@@ -14,11 +15,27 @@ type (
 		Regex         string `json:"regex,omitempty" query:"regex" validate:"regex"`
 		StatusCodeMin *int   `json:"statusCodeMin,omitempty" query:"statusCodeMin"`
 		StatusCodeMax *int   `json:"statusCodeMax,omitempty" query:"statusCodeMax"`
+
+		Method  string   `json:"method,omitempty" query:"method" validate:"omitempty,oneof=GET POST PUT PATCH DELETE HEAD OPTIONS"`
+		Headers []string `json:"headers,omitempty" query:"headers"`
+		Body    string   `json:"body,omitempty" query:"body"`
+
+		Assertions []string `json:"assertions,omitempty" query:"assertions"`
+
+		Unit      coreModels.TileValuesUnit `json:"unit,omitempty" query:"unit" validate:"omitempty,oneof=MILLISECOND SECOND RATIO PERCENT NUMBER BYTES BINARY_BYTES CURRENCY PER_SECOND PER_MINUTE RAW"`
+		Precision *int                      `json:"precision,omitempty" query:"precision" validate:"omitempty,gte=0,lte=10"`
+		Prefix    string                    `json:"prefix,omitempty" query:"prefix"`
+		Suffix    string                    `json:"suffix,omitempty" query:"suffix"`
+		Currency  string                    `json:"currency,omitempty" query:"currency"`
 	}
 )
 
 func (p *HTTPRawParams) Validate() []validator.Error {
-	return validateStatusCode(p)
+	errors := validateStatusCode(p)
+	errors = append(errors, validateHeaders(p.Headers)...)
+	errors = append(errors, validateAssertions(p.Assertions)...)
+	errors = append(errors, validateValueFormat(p)...)
+	return errors
 }
 
 func (p *HTTPRawParams) GetURL() (url string) { return p.URL }
@@ -26,5 +43,17 @@ func (p *HTTPRawParams) GetStatusCodes() (min int, max int) {
 	return getStatusCodesWithDefault(p.StatusCodeMin, p.StatusCodeMax)
 }
 
+func (p *HTTPRawParams) GetMethod() string             { return getMethodWithDefault(p.Method) }
+func (p *HTTPRawParams) GetHeaders() map[string]string { return getHeaders(p.Headers) }
+func (p *HTTPRawParams) GetBody() string               { return p.Body }
+
+func (p *HTTPRawParams) GetAssertions() []*Assertion { return getAssertions(p.Assertions) }
+
 func (p *HTTPRawParams) GetRegex() string          { return p.Regex }
 func (p *HTTPRawParams) GetRegexp() *regexp.Regexp { return getRegexp(p.GetRegex()) }
+
+func (p *HTTPRawParams) GetUnit() coreModels.TileValuesUnit { return p.Unit }
+func (p *HTTPRawParams) GetPrecision() *int                 { return p.Precision }
+func (p *HTTPRawParams) GetPrefix() string                  { return p.Prefix }
+func (p *HTTPRawParams) GetSuffix() string                  { return p.Suffix }
+func (p *HTTPRawParams) GetCurrency() string                { return p.Currency }
@@ -16,6 +16,18 @@ type (
 		StatusCodeMin *int   `json:"statusCodeMin,omitempty" query:"statusCodeMin"`
 		StatusCodeMax *int   `json:"statusCodeMax,omitempty" query:"statusCodeMax"`
 
+		Method  string   `json:"method,omitempty" query:"method" validate:"omitempty,oneof=GET POST PUT PATCH DELETE HEAD OPTIONS"`
+		Headers []string `json:"headers,omitempty" query:"headers"`
+		Body    string   `json:"body,omitempty" query:"body"`
+
+		Assertions []string `json:"assertions,omitempty" query:"assertions"`
+
+		Unit      coreModels.TileValuesUnit `json:"unit,omitempty" query:"unit" validate:"omitempty,oneof=MILLISECOND SECOND RATIO PERCENT NUMBER BYTES BINARY_BYTES CURRENCY PER_SECOND PER_MINUTE RAW"`
+		Precision *int                      `json:"precision,omitempty" query:"precision" validate:"omitempty,gte=0,lte=10"`
+		Prefix    string                    `json:"prefix,omitempty" query:"prefix"`
+		Suffix    string                    `json:"suffix,omitempty" query:"suffix"`
+		Currency  string                    `json:"currency,omitempty" query:"currency"`
+
 		Status      coreModels.TileStatus     `json:"status" query:"status"`
 		Message     string                    `json:"message" query:"message"`
 		ValueValues []string                  `json:"valueValues" query:"valueValues"`
@@ -24,7 +36,11 @@ type (
 )
 
 func (p *HTTPRawParams) Validate() []validator.Error {
-	return validateStatusCode(p)
+	errors := validateStatusCode(p)
+	errors = append(errors, validateHeaders(p.Headers)...)
+	errors = append(errors, validateAssertions(p.Assertions)...)
+	errors = append(errors, validateValueFormat(p)...)
+	return errors
 }
 
 func (p *HTTPRawParams) GetURL() (url string) { return p.URL }
@@ -32,9 +48,21 @@ func (p *HTTPRawParams) GetStatusCodes() (min int, max int) {
 	return getStatusCodesWithDefault(p.StatusCodeMin, p.StatusCodeMax)
 }
 
+func (p *HTTPRawParams) GetMethod() string             { return getMethodWithDefault(p.Method) }
+func (p *HTTPRawParams) GetHeaders() map[string]string { return getHeaders(p.Headers) }
+func (p *HTTPRawParams) GetBody() string               { return p.Body }
+
+func (p *HTTPRawParams) GetAssertions() []*Assertion { return getAssertions(p.Assertions) }
+
 func (p *HTTPRawParams) GetRegex() string          { return p.Regex }
 func (p *HTTPRawParams) GetRegexp() *regexp.Regexp { return getRegexp(p.GetRegex()) }
 
+func (p *HTTPRawParams) GetUnit() coreModels.TileValuesUnit { return p.Unit }
+func (p *HTTPRawParams) GetPrecision() *int                 { return p.Precision }
+func (p *HTTPRawParams) GetPrefix() string                  { return p.Prefix }
+func (p *HTTPRawParams) GetSuffix() string                  { return p.Suffix }
+func (p *HTTPRawParams) GetCurrency() string                { return p.Currency }
+
 func (p *HTTPRawParams) GetStatus() coreModels.TileStatus        { return p.Status }
 func (p *HTTPRawParams) GetMessage() string                      { return p.Message }
 func (p *HTTPRawParams) GetValueValues() []string                { return p.ValueValues }
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sort"
+)
+
+type (
+	Request struct {
+		Method  string
+		URL     string
+		Headers map[string]string
+		Body    string
+
+		// NewConnection open a new connection instead of reusing an idle one, to measure all timings
+		NewConnection bool
+	}
+)
+
+// CacheKey identify requests sharing the same response
+func (r *Request) CacheKey() string {
+	if r.Method == DefaultMethod && len(r.Headers) == 0 && r.Body == "" && !r.NewConnection {
+		return r.URL
+	}
+
+	names := make([]string, 0, len(r.Headers))
+	for name := range r.Headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	hash := sha256.New()
+	for _, name := range names {
+		_, _ = fmt.Fprintf(hash, "%s: %s\n", name, r.Headers[name])
+	}
+	_, _ = fmt.Fprintf(hash, "\n%s\n%t", r.Body, r.NewConnection)
+
+	return fmt.Sprintf("%s:%s:%x", r.Method, r.URL, hash.Sum(nil))
+}
@@ -0,0 +1,23 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequest_CacheKey(t *testing.T) {
+	request := &Request{Method: "GET", URL: "http://example.com"}
+	assert.Equal(t, "http://example.com", request.CacheKey())
+
+	post := &Request{Method: "POST", URL: "http://example.com", Body: "{}"}
+	assert.NotEqual(t, request.CacheKey(), post.CacheKey())
+	assert.NotEqual(t, post.CacheKey(), (&Request{Method: "POST", URL: "http://example.com", Body: "[]"}).CacheKey())
+
+	withHeaders := &Request{Method: "GET", URL: "http://example.com", Headers: map[string]string{"A": "1", "B": "2"}}
+	assert.NotEqual(t, request.CacheKey(), withHeaders.CacheKey())
+	for i := 0; i < 10; i++ {
+		// Map order doesn't change key
+		assert.Equal(t, withHeaders.CacheKey(), (&Request{Method: "GET", URL: "http://example.com", Headers: map[string]string{"B": "2", "A": "1"}}).CacheKey())
+	}
+}
@@ -1,8 +1,29 @@
 package models
 
+import (
+	"net/http"
+	"time"
+)
+
 type (
 	Response struct {
 		StatusCode int
+		Header     http.Header
 		Body       []byte
+
+		// CertificateExpiry is the expiration date of the server certificate, nil without TLS
+		CertificateExpiry *time.Time
+
+		Timings Timings
+	}
+
+	// Timings of request phases, measured with httptrace.
+	// DNS, Connect and TLSHandshake are zero when connection is reused
+	Timings struct {
+		DNS          time.Duration
+		Connect      time.Duration
+		TLSHandshake time.Duration
+		FirstByte    time.Duration // Time to first byte, since the beginning of the request
+		Total        time.Duration
 	}
 )
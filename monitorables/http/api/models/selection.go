@@ -0,0 +1,21 @@
+package models
+
+import (
+	"github.com/andybalholm/cascadia"
+)
+
+type Aggregate string
+
+const (
+	FirstAggregate   Aggregate = "FIRST"
+	LastAggregate    Aggregate = "LAST"
+	CountAggregate   Aggregate = "COUNT"
+	SumAggregate     Aggregate = "SUM"
+	AverageAggregate Aggregate = "AVERAGE"
+	MinAggregate     Aggregate = "MIN"
+	MaxAggregate     Aggregate = "MAX"
+)
+
+func NewCSSSelector(selector string) (cascadia.Selector, error) {
+	return cascadia.Compile(selector)
+}
@@ -11,14 +11,29 @@ type (
 		URL           string `json:"url" query:"url" validate:"required,url,http"`
 		StatusCodeMin *int   `json:"statusCodeMin,omitempty" query:"statusCodeMin"`
 		StatusCodeMax *int   `json:"statusCodeMax,omitempty" query:"statusCodeMax"`
+
+		Method  string   `json:"method,omitempty" query:"method" validate:"omitempty,oneof=GET POST PUT PATCH DELETE HEAD OPTIONS"`
+		Headers []string `json:"headers,omitempty" query:"headers"`
+		Body    string   `json:"body,omitempty" query:"body"`
+
+		Assertions []string `json:"assertions,omitempty" query:"assertions"`
 	}
 )
 
 func (p *HTTPStatusParams) Validate() []validator.Error {
-	return validateStatusCode(p)
+	errors := validateStatusCode(p)
+	errors = append(errors, validateHeaders(p.Headers)...)
+	errors = append(errors, validateAssertions(p.Assertions)...)
+	return errors
 }
 
 func (p *HTTPStatusParams) GetURL() (url string) { return p.URL }
 func (p *HTTPStatusParams) GetStatusCodes() (min int, max int) {
 	return getStatusCodesWithDefault(p.StatusCodeMin, p.StatusCodeMax)
 }
+
+func (p *HTTPStatusParams) GetMethod() string             { return getMethodWithDefault(p.Method) }
+func (p *HTTPStatusParams) GetHeaders() map[string]string { return getHeaders(p.Headers) }
+func (p *HTTPStatusParams) GetBody() string               { return p.Body }
+
+func (p *HTTPStatusParams) GetAssertions() []*Assertion { return getAssertions(p.Assertions) }
@@ -13,13 +13,22 @@ type (
 		StatusCodeMin *int   `json:"statusCodeMin,omitempty" query:"statusCodeMin"`
 		StatusCodeMax *int   `json:"statusCodeMax,omitempty" query:"statusCodeMax"`
 
+		Method  string   `json:"method,omitempty" query:"method" validate:"omitempty,oneof=GET POST PUT PATCH DELETE HEAD OPTIONS"`
+		Headers []string `json:"headers,omitempty" query:"headers"`
+		Body    string   `json:"body,omitempty" query:"body"`
+
+		Assertions []string `json:"assertions,omitempty" query:"assertions"`
+
 		Status  coreModels.TileStatus `json:"status" query:"status"`
 		Message string                `json:"message" query:"message"`
 	}
 )
 
 func (p *HTTPStatusParams) Validate() []validator.Error {
-	return validateStatusCode(p)
+	errors := validateStatusCode(p)
+	errors = append(errors, validateHeaders(p.Headers)...)
+	errors = append(errors, validateAssertions(p.Assertions)...)
+	return errors
 }
 
 func (p *HTTPStatusParams) GetURL() (url string) { return p.URL }
@@ -27,6 +36,12 @@ func (p *HTTPStatusParams) GetStatusCodes() (min int, max int) {
 	return getStatusCodesWithDefault(p.StatusCodeMin, p.StatusCodeMax)
 }
 
+func (p *HTTPStatusParams) GetMethod() string             { return getMethodWithDefault(p.Method) }
+func (p *HTTPStatusParams) GetHeaders() map[string]string { return getHeaders(p.Headers) }
+func (p *HTTPStatusParams) GetBody() string               { return p.Body }
+
+func (p *HTTPStatusParams) GetAssertions() []*Assertion { return getAssertions(p.Assertions) }
+
 func (p *HTTPStatusParams) GetStatus() coreModels.TileStatus        { return p.Status }
 func (p *HTTPStatusParams) GetMessage() string                      { return p.Message }
 func (p *HTTPStatusParams) GetValueValues() []string                { panic("unimplemented") }
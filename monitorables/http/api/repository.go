@@ -8,6 +8,6 @@ import (
 
 type (
 	Repository interface {
-		Get(url string) (*models.Response, error)
+		Do(request *models.Request) (*models.Response, error)
 	}
 )
@@ -1,33 +1,125 @@
 package repository
 
 import (
-	"crypto/tls"
+	"context"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptrace"
+	"strings"
 	"time"
 
 	"github.com/monitoror/monitoror/monitorables/http/api"
 	"github.com/monitoror/monitoror/monitorables/http/api/models"
 	"github.com/monitoror/monitoror/monitorables/http/config"
+	"github.com/monitoror/monitoror/pkg/urlmatch"
+
+	"golang.org/x/oauth2"
 )
 
 type (
 	httpRepository struct {
 		httpClient *http.Client
+		// newConnectionHTTPClient never reuse connections, used to measure all timings of requests
+		newConnectionHTTPClient *http.Client
+		config                  *config.HTTP
+
+		// headers added to every request, before headers of request
+		headers map[string]string
+		// credentialsURLPrefixes URLs receiving credentials of config, OAuth2 token included
+		credentialsURLPrefixes []string
+
+		// tokenSource provide OAuth2 access token, nil without OAuth2 config
+		tokenSource oauth2.TokenSource
 	}
 )
 
 func NewHTTPRepository(config *config.HTTP) api.Repository {
+	repository := &httpRepository{config: config}
+
+	// Already validate by Monitorable.Validate
+	proxy, _ := config.GetProxy()
+	tlsConfig, _ := config.GetTLSConfig()
+	repository.headers, _ = config.GetHeaders()
+	repository.credentialsURLPrefixes, _ = config.GetCredentialsURLPrefixes()
+
 	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: !config.SSLVerify},
+		Proxy:             proxy,
+		TLSClientConfig:   tlsConfig,
+		ForceAttemptHTTP2: config.HTTP2,
+	}
+	repository.httpClient = &http.Client{
+		Transport:     tr,
+		CheckRedirect: repository.checkRedirect,
+		Timeout:       time.Duration(config.Timeout) * time.Millisecond,
+	}
+
+	newConnectionTr := tr.Clone()
+	newConnectionTr.DisableKeepAlives = true
+	repository.newConnectionHTTPClient = &http.Client{
+		Transport:     newConnectionTr,
+		CheckRedirect: repository.checkRedirect,
+		Timeout:       repository.httpClient.Timeout,
+	}
+
+	if oauth2Config, _ := config.GetOAuth2Config(); oauth2Config != nil {
+		// Token is fetched with the same client (proxy, TLS), then cached and refreshed before it expires
+		ctx := context.WithValue(context.Background(), oauth2.HTTPClient, repository.httpClient)
+		repository.tokenSource = oauth2Config.TokenSource(ctx)
 	}
-	client := &http.Client{Transport: tr, Timeout: time.Duration(config.Timeout) * time.Millisecond}
 
-	return &httpRepository{client}
+	return repository
 }
 
-func (r *httpRepository) Get(url string) (response *models.Response, err error) {
-	resp, err := r.httpClient.Get(url)
+func (r *httpRepository) Do(request *models.Request) (response *models.Response, err error) {
+	var body io.Reader
+	if request.Body != "" {
+		body = strings.NewReader(request.Body)
+	}
+
+	req, err := http.NewRequest(request.Method, request.URL, body)
+	if err != nil {
+		return
+	}
+
+	for name, value := range r.headers {
+		setHeader(req, name, value)
+	}
+	for name, value := range request.Headers {
+		setHeader(req, name, value)
+	}
+
+	// Credentials are set last, they can't be overridden by dashboard config
+	if r.isCredentialsURL(request.URL) {
+		if r.config.Username != "" || r.config.Password != "" {
+			req.SetBasicAuth(r.config.Username, r.config.Password)
+		}
+		if r.config.Token != "" {
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", r.config.Token))
+		}
+		if r.config.APIKey != "" {
+			req.Header.Set(r.config.APIKeyHeader, r.config.APIKey)
+		}
+		if r.tokenSource != nil {
+			token, tokenErr := r.tokenSource.Token()
+			if tokenErr != nil {
+				err = &models.TokenError{TokenURL: r.config.OAuth2TokenURL, Err: tokenErr}
+				return
+			}
+			token.SetAuthHeader(req)
+		}
+	}
+
+	client := r.httpClient
+	if request.NewConnection {
+		client = r.newConnectionHTTPClient
+	}
+
+	tracer := newTracer()
+	req = req.WithContext(httptrace.WithClientTrace(req.Context(), tracer.clientTrace()))
+
+	resp, err := client.Do(req)
 	if err != nil {
 		return
 	}
@@ -40,8 +132,51 @@ func (r *httpRepository) Get(url string) (response *models.Response, err error)
 
 	response = &models.Response{
 		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
 		Body:       bytes,
+		Timings:    tracer.timings(),
+	}
+	if resp.TLS != nil && len(resp.TLS.PeerCertificates) > 0 {
+		response.CertificateExpiry = &resp.TLS.PeerCertificates[0].NotAfter
 	}
 
 	return
 }
+
+// checkRedirect follow up to MaxRedirects redirects, the redirect response is returned when MaxRedirects is 0.
+// Credentials are removed when redirected outside of credentialsURLPrefixes
+func (r *httpRepository) checkRedirect(req *http.Request, via []*http.Request) error {
+	if r.config.MaxRedirects == 0 {
+		return http.ErrUseLastResponse
+	}
+	if len(via) > r.config.MaxRedirects {
+		return fmt.Errorf("stopped after %d redirects", r.config.MaxRedirects)
+	}
+
+	if !r.isCredentialsURL(req.URL.String()) {
+		req.Header.Del("Authorization")
+		if r.config.APIKeyHeader != "" {
+			req.Header.Del(r.config.APIKeyHeader)
+		}
+	}
+	return nil
+}
+
+// isCredentialsURL return true when rawURL match one of credentialsURLPrefixes (see urlmatch.HasPrefix)
+func (r *httpRepository) isCredentialsURL(rawURL string) bool {
+	for _, prefix := range r.credentialsURLPrefixes {
+		if urlmatch.HasPrefix(rawURL, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// setHeader set header on request, Host header is ignored by http.Client and need to be set on request
+func setHeader(req *http.Request, name, value string) {
+	if strings.EqualFold(name, "Host") {
+		req.Host = value
+		return
+	}
+	req.Header.Set(name, value)
+}
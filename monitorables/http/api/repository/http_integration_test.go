@@ -1,14 +1,28 @@
 package repository
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
 	"fmt"
 	"io/ioutil"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"testing"
 	"testing/iotest"
+	"time"
 
+	"github.com/monitoror/monitoror/monitorables/http/api/models"
 	"github.com/monitoror/monitoror/monitorables/http/config"
 	"github.com/monitoror/monitoror/pkg/test"
 
@@ -26,17 +40,288 @@ func TestHTTPRepository_Get(t *testing.T) {
 	defer ts.Close()
 
 	repository := NewHTTPRepository(&config.HTTP{SSLVerify: false, Timeout: 2000})
-	response, err := repository.Get(ts.URL)
+	response, err := repository.Do(&models.Request{Method: "GET", URL: ts.URL})
 
 	if assert.NoError(t, err) {
 		assert.Equal(t, 200, response.StatusCode)
 		assert.Equal(t, "Hello", strings.TrimSpace(string(response.Body)))
+		assert.Equal(t, "text/plain; charset=utf-8", response.Header.Get("Content-Type"))
+		assert.Nil(t, response.CertificateExpiry)
+	}
+}
+
+func TestHTTPRepository_Do_WithHeadersAndCredentials(t *testing.T) {
+	for _, testcase := range []struct {
+		config          *config.HTTP
+		expectedHeaders map[string]string
+	}{
+		{
+			config:          &config.HTTP{Timeout: 2000, Headers: `{"X-Api-Version": "1", "X-Team": "ops"}`},
+			expectedHeaders: map[string]string{"X-Api-Version": "2", "X-Team": "ops", "Content-Type": "application/json"},
+		},
+		{
+			config:          &config.HTTP{Timeout: 2000, Username: "user", Password: "pass"},
+			expectedHeaders: map[string]string{"Authorization": "Basic dXNlcjpwYXNz"},
+		},
+		{
+			config:          &config.HTTP{Timeout: 2000, Token: "token"},
+			expectedHeaders: map[string]string{"Authorization": "Bearer token"},
+		},
+		{
+			config:          &config.HTTP{Timeout: 2000, APIKey: "key", APIKeyHeader: "X-API-Key"},
+			expectedHeaders: map[string]string{"X-Api-Key": "key"},
+		},
+	} {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "POST", r.Method)
+			for name, value := range testcase.expectedHeaders {
+				assert.Equal(t, value, r.Header.Get(name))
+			}
+			body, _ := ioutil.ReadAll(r.Body)
+			assert.Equal(t, `{"query": "{ health }"}`, string(body))
+		}))
+
+		testcase.config.CredentialsURLPrefixes = ts.URL
+		repository := NewHTTPRepository(testcase.config)
+		response, err := repository.Do(&models.Request{
+			Method: "POST",
+			URL:    ts.URL,
+			// Authorization can't be overridden by dashboard config
+			Headers: map[string]string{"X-Api-Version": "2", "Content-Type": "application/json", "Authorization": "Bearer dashboard"},
+			Body:    `{"query": "{ health }"}`,
+		})
+		if assert.NoError(t, err) {
+			assert.Equal(t, 200, response.StatusCode)
+		}
+
+		ts.Close()
+	}
+}
+
+func TestHTTPRepository_Do_WithCredentialsURLPrefixes(t *testing.T) {
+	credentials := func(r *http.Request) string {
+		return r.Header.Get("Authorization") + "|" + r.Header.Get("X-Api-Key")
+	}
+
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, credentials(r))
+	}))
+	defer other.Close()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/redirect" {
+			http.Redirect(w, r, other.URL, http.StatusFound)
+			return
+		}
+		_, _ = fmt.Fprint(w, credentials(r))
+	}))
+	defer ts.Close()
+
+	repository := NewHTTPRepository(&config.HTTP{
+		Timeout:                2000,
+		MaxRedirects:           10,
+		Token:                  "token",
+		APIKey:                 "key",
+		APIKeyHeader:           "X-API-Key",
+		CredentialsURLPrefixes: ts.URL + "/api",
+	})
+
+	for _, testcase := range []struct {
+		url          string
+		expectedBody string
+	}{
+		{url: ts.URL + "/api/health", expectedBody: "Bearer token|key"},
+		{url: ts.URL + "/apiv2", expectedBody: "|"},
+		{url: other.URL + "/api/health", expectedBody: "|"},
+		// Credentials are removed when redirected outside of prefixes
+		{url: ts.URL + "/api/redirect", expectedBody: "|"},
+	} {
+		response, err := repository.Do(&models.Request{Method: "GET", URL: testcase.url})
+		if assert.NoError(t, err, testcase.url) {
+			assert.Equal(t, testcase.expectedBody, string(response.Body), testcase.url)
+		}
+	}
+}
+
+func TestHTTPRepository_Do_WithTimings(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(10 * time.Millisecond)
+		_, _ = fmt.Fprintln(w, "Hello")
+	}))
+	defer ts.Close()
+
+	repository := NewHTTPRepository(&config.HTTP{SSLVerify: false, Timeout: 2000})
+	for i := 0; i < 2; i++ {
+		response, err := repository.Do(&models.Request{Method: "GET", URL: ts.URL, NewConnection: true})
+		if assert.NoError(t, err) {
+			assert.NotZero(t, response.Timings.Connect)
+			assert.NotZero(t, response.Timings.TLSHandshake)
+			assert.True(t, response.Timings.FirstByte >= 10*time.Millisecond)
+			assert.True(t, response.Timings.Total >= response.Timings.FirstByte)
+			assert.NotNil(t, response.CertificateExpiry)
+		}
+	}
+}
+
+func TestHTTPRepository_Do_WithRedirects(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hops, _ := strconv.Atoi(r.URL.Query().Get("hops")); hops > 0 {
+			http.Redirect(w, r, fmt.Sprintf("/?hops=%d", hops-1), http.StatusFound)
+			return
+		}
+		_, _ = fmt.Fprintln(w, "Hello")
+	}))
+	defer ts.Close()
+
+	for _, testcase := range []struct {
+		maxRedirects       int
+		expectedError      bool
+		expectedStatusCode int
+	}{
+		{maxRedirects: 0, expectedStatusCode: http.StatusFound},
+		{maxRedirects: 2, expectedStatusCode: http.StatusOK},
+		{maxRedirects: 1, expectedError: true},
+	} {
+		repository := NewHTTPRepository(&config.HTTP{Timeout: 2000, MaxRedirects: testcase.maxRedirects})
+		response, err := repository.Do(&models.Request{Method: "GET", URL: ts.URL + "/?hops=2"})
+		if testcase.expectedError {
+			assert.Error(t, err)
+		} else if assert.NoError(t, err) {
+			assert.Equal(t, testcase.expectedStatusCode, response.StatusCode)
+		}
+	}
+}
+
+func TestHTTPRepository_Do_WithProxy(t *testing.T) {
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Proxy receive absolute URL
+		_, _ = fmt.Fprint(w, r.URL.String())
+	}))
+	defer proxy.Close()
+
+	for _, testcase := range []struct {
+		noProxy      string
+		expectedBody string
+	}{
+		{noProxy: "", expectedBody: "http://monitoror.example.com/status"},
+		{noProxy: "localhost,.example.com", expectedBody: ""},
+	} {
+		repository := NewHTTPRepository(&config.HTTP{Timeout: 2000, Proxy: proxy.URL, NoProxy: testcase.noProxy})
+		response, err := repository.Do(&models.Request{Method: "GET", URL: "http://monitoror.example.com/status"})
+		if testcase.expectedBody == "" {
+			assert.Error(t, err)
+		} else if assert.NoError(t, err) {
+			assert.Equal(t, testcase.expectedBody, string(response.Body))
+		}
+	}
+}
+
+func TestHTTPRepository_Do_WithClientCertificateAndCACert(t *testing.T) {
+	dir, err := ioutil.TempDir("", "monitoror")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	// Client certificate
+	clientCert, clientKey, certificate := writeCertificate(t, dir)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(certificate)
+
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, r.TLS.PeerCertificates[0].Subject.CommonName)
+	}))
+	ts.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	ts.StartTLS()
+	defer ts.Close()
+
+	// CA bundle trusting test server
+	caCert := filepath.Join(dir, "ca.pem")
+	if !assert.NoError(t, ioutil.WriteFile(caCert, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw}), 0600)) {
+		return
+	}
+
+	for _, testcase := range []struct {
+		config        *config.HTTP
+		expectedError bool
+	}{
+		{config: &config.HTTP{Timeout: 2000, SSLVerify: true, CACert: caCert}, expectedError: true},
+		{config: &config.HTTP{Timeout: 2000, SSLVerify: true, ClientCert: clientCert, ClientKey: clientKey}, expectedError: true},
+		{config: &config.HTTP{Timeout: 2000, SSLVerify: true, ClientCert: clientCert, ClientKey: clientKey, CACert: caCert}},
+	} {
+		repository := NewHTTPRepository(testcase.config)
+		response, err := repository.Do(&models.Request{Method: "GET", URL: ts.URL})
+		if testcase.expectedError {
+			assert.Error(t, err)
+		} else if assert.NoError(t, err) {
+			assert.Equal(t, "monitoror", string(response.Body))
+		}
+	}
+}
+
+func TestHTTPRepository_Do_WithOAuth2(t *testing.T) {
+	tokenCalls := 0
+	auth := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenCalls++
+		_ = r.ParseForm()
+		assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "read:health write:metrics", r.PostForm.Get("scope"))
+		assert.Equal(t, "https://api.example.com", r.PostForm.Get("audience"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"access_token": "token", "token_type": "bearer", "expires_in": 3600}`)
+	}))
+	defer auth.Close()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, r.Header.Get("Authorization"))
+	}))
+	defer ts.Close()
+
+	other := httptest.NewServer(ts.Config.Handler)
+	defer other.Close()
+
+	repository := NewHTTPRepository(&config.HTTP{
+		Timeout:                2000,
+		OAuth2TokenURL:         auth.URL,
+		OAuth2ClientID:         "monitoror",
+		OAuth2ClientSecret:     "secret",
+		OAuth2Scopes:           "read:health, write:metrics",
+		OAuth2Audience:         "https://api.example.com",
+		CredentialsURLPrefixes: ts.URL,
+	})
+	for i := 0; i < 2; i++ {
+		response, err := repository.Do(&models.Request{Method: "GET", URL: ts.URL})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "Bearer token", string(response.Body))
+		}
+	}
+	// Token is cached
+	assert.Equal(t, 1, tokenCalls)
+
+	// Token is only sent to URL prefixes
+	response, err := repository.Do(&models.Request{Method: "GET", URL: other.URL})
+	if assert.NoError(t, err) {
+		assert.Empty(t, string(response.Body))
+	}
+
+	// Token error
+	failingAuth := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer failingAuth.Close()
+
+	repository = NewHTTPRepository(&config.HTTP{Timeout: 2000, OAuth2TokenURL: failingAuth.URL, OAuth2ClientID: "monitoror", CredentialsURLPrefixes: ts.URL})
+	_, err = repository.Do(&models.Request{Method: "GET", URL: ts.URL})
+	var tokenErr *models.TokenError
+	if assert.True(t, errors.As(err, &tokenErr)) {
+		assert.Equal(t, failingAuth.URL, tokenErr.TokenURL)
 	}
 }
 
 func TestHTTPRepository_Get_Error(t *testing.T) {
 	repository := NewHTTPRepository(&config.HTTP{SSLVerify: false, Timeout: 2000})
-	_, err := repository.Get("http://monitoror.example.com")
+	_, err := repository.Do(&models.Request{Method: "GET", URL: "http://monitoror.example.com"})
 	assert.Error(t, err)
 }
 
@@ -49,8 +334,42 @@ func TestHTTPRepository_Get_ReadAll_Error(t *testing.T) {
 			Header:     make(http.Header),
 		}
 	})
-	repository := httpRepository{httpClient: client}
+	repository := httpRepository{httpClient: client, config: &config.HTTP{}}
 
-	_, err := repository.Get("http://monitoror.example.com")
+	_, err := repository.Do(&models.Request{Method: "GET", URL: "http://monitoror.example.com"})
 	assert.Error(t, err)
 }
+
+// writeCertificate generate self-signed client certificate and write it with its key in dir
+func writeCertificate(t *testing.T, dir string) (certPath string, keyPath string, certificate *x509.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "monitoror"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	certificate, _ = x509.ParseCertificate(der)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	certPath = filepath.Join(dir, "client.pem")
+	keyPath = filepath.Join(dir, "client.key")
+	_ = ioutil.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600)
+	_ = ioutil.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600)
+
+	return
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"crypto/tls"
+	"net/http/httptrace"
+	"sync"
+	"time"
+
+	"github.com/monitoror/monitoror/monitorables/http/api/models"
+)
+
+type (
+	// tracer measure timings of a request phases. Hooks can be called concurrently (ex: dual stack dial)
+	tracer struct {
+		sync.Mutex
+
+		start time.Time
+
+		dnsStart, dnsDone         time.Time
+		connectStart, connectDone time.Time
+		tlsStart, tlsDone         time.Time
+		firstByte                 time.Time
+	}
+)
+
+func newTracer() *tracer {
+	return &tracer{start: time.Now()}
+}
+
+func (t *tracer) clientTrace() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		DNSStart: func(_ httptrace.DNSStartInfo) { t.set(&t.dnsStart, false) },
+		DNSDone:  func(_ httptrace.DNSDoneInfo) { t.set(&t.dnsDone, true) },
+		ConnectStart: func(_, _ string) {
+			t.set(&t.connectStart, false)
+		},
+		ConnectDone: func(_, _ string, err error) {
+			if err == nil {
+				t.set(&t.connectDone, true)
+			}
+		},
+		TLSHandshakeStart: func() { t.set(&t.tlsStart, false) },
+		TLSHandshakeDone: func(_ tls.ConnectionState, err error) {
+			if err == nil {
+				t.set(&t.tlsDone, true)
+			}
+		},
+		GotFirstResponseByte: func() { t.set(&t.firstByte, false) },
+	}
+}
+
+// set store current time in field. First time is kept, unless override is true
+func (t *tracer) set(field *time.Time, override bool) {
+	t.Lock()
+	defer t.Unlock()
+
+	if field.IsZero() || override {
+		*field = time.Now()
+	}
+}
+
+// timings return measured timings, call it once response body is read
+func (t *tracer) timings() models.Timings {
+	t.Lock()
+	defer t.Unlock()
+
+	return models.Timings{
+		DNS:          duration(t.dnsStart, t.dnsDone),
+		Connect:      duration(t.connectStart, t.connectDone),
+		TLSHandshake: duration(t.tlsStart, t.tlsDone),
+		FirstByte:    duration(t.start, t.firstByte),
+		Total:        time.Since(t.start),
+	}
+}
+
+func duration(start, end time.Time) time.Duration {
+	if start.IsZero() || end.IsZero() {
+		return 0
+	}
+	return end.Sub(start)
+}
@@ -11,6 +11,7 @@ const (
 	HTTPStatusTileType    coreModels.TileType = "HTTP-STATUS"
 	HTTPRawTileType       coreModels.TileType = "HTTP-RAW"
 	HTTPFormattedTileType coreModels.TileType = "HTTP-FORMATTED"
+	HTTPLatencyTileType   coreModels.TileType = "HTTP-LATENCY"
 )
 
 type (
@@ -18,5 +19,6 @@ type (
 		HTTPStatus(params *models.HTTPStatusParams) (*coreModels.Tile, error)
 		HTTPRaw(params *models.HTTPRawParams) (*coreModels.Tile, error)
 		HTTPFormatted(params *models.HTTPFormattedParams) (*coreModels.Tile, error)
+		HTTPLatency(params *models.HTTPLatencyParams) (*coreModels.Tile, error)
 	}
 )
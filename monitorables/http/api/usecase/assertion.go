@@ -0,0 +1,122 @@
+//+build !faker
+
+package usecase
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/http/api/models"
+)
+
+// checkAssertions check assertions on response, failed assertions are listed in tile message
+// and change tile status depending of their severity
+func checkAssertions(tile *coreModels.Tile, params models.GenericParamsProvider, response *models.Response) {
+	var failedAssertions []string
+	for _, assertion := range params.GetAssertions() {
+		if checkAssertion(assertion, response) {
+			continue
+		}
+
+		failedAssertions = append(failedAssertions, assertion.Raw)
+		if assertion.Severity == coreModels.FailedStatus {
+			tile.Status = coreModels.FailedStatus
+		} else if tile.Status == coreModels.SuccessStatus {
+			tile.Status = coreModels.WarningStatus
+		}
+	}
+
+	if len(failedAssertions) > 0 {
+		tile.Message = fmt.Sprintf("failed assertions: %s", strings.Join(failedAssertions, ", "))
+	}
+}
+
+// hasStatusAssertion return true when params contain an assertion on status code
+func hasStatusAssertion(params models.GenericParamsProvider) bool {
+	for _, assertion := range params.GetAssertions() {
+		if assertion.Subject == models.StatusSubject {
+			return true
+		}
+	}
+	return false
+}
+
+// checkAssertion return true if assertion is verified by response
+func checkAssertion(assertion *models.Assertion, response *models.Response) bool {
+	var actual string
+
+	switch assertion.Subject {
+	case models.StatusSubject:
+		actual = strconv.Itoa(response.StatusCode)
+	case models.SizeSubject:
+		actual = strconv.Itoa(len(response.Body))
+	case models.CertificateDaysSubject:
+		if response.CertificateExpiry == nil {
+			return false
+		}
+		actual = strconv.Itoa(int(math.Floor(time.Until(*response.CertificateExpiry).Hours() / 24)))
+	case models.BodySubject:
+		actual = string(response.Body)
+	case models.HeaderSubject:
+		if len(response.Header.Values(assertion.Name)) == 0 {
+			return false
+		}
+		actual = response.Header.Get(assertion.Name)
+	case models.JSONSubject:
+		var data interface{}
+		if err := json.Unmarshal(response.Body, &data); err != nil {
+			return false
+		}
+
+		var found bool
+		if found, actual = lookupKey(&models.HTTPFormattedParams{Key: assertion.Name}, data); !found {
+			return false
+		}
+	}
+
+	return compare(assertion, actual)
+}
+
+// compare actual value with assertion value, numbers are compared as numbers when both values are numbers
+func compare(assertion *models.Assertion, actual string) bool {
+	actualNumber, actualErr := strconv.ParseFloat(actual, 64)
+	expectedNumber, expectedErr := strconv.ParseFloat(assertion.Value, 64)
+	isNumber := actualErr == nil && expectedErr == nil
+
+	switch assertion.Operator {
+	case models.EqualOperator:
+		if isNumber {
+			return actualNumber == expectedNumber
+		}
+		return actual == assertion.Value
+	case models.NotEqualOperator:
+		if isNumber {
+			return actualNumber != expectedNumber
+		}
+		return actual != assertion.Value
+	case models.LowerOperator:
+		return isNumber && actualNumber < expectedNumber
+	case models.LowerOrEqualOperator:
+		return isNumber && actualNumber <= expectedNumber
+	case models.GreaterOperator:
+		return isNumber && actualNumber > expectedNumber
+	case models.GreaterOrEqualOperator:
+		return isNumber && actualNumber >= expectedNumber
+	case models.BetweenOperator:
+		min, max, _ := assertion.Bounds() // Already validate by ParseAssertion
+		return actualErr == nil && min <= actualNumber && actualNumber <= max
+	case models.ContainsOperator:
+		return strings.Contains(actual, assertion.Value)
+	case models.MatchesOperator:
+		regex, _ := regexp.Compile(assertion.Value) // Already validate by ParseAssertion
+		return regex.MatchString(actual)
+	}
+
+	return false
+}
@@ -4,7 +4,9 @@ package usecase
 
 import (
 	"bytes"
+	"encoding/csv"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -16,6 +18,8 @@ import (
 	"github.com/monitoror/monitoror/monitorables/http/api/models"
 	"github.com/monitoror/monitoror/pkg/humanize"
 
+	"github.com/PuerkitoBio/goquery"
+	"github.com/antchfx/xmlquery"
 	xml2json "github.com/basgys/goxml2json"
 	"github.com/ghodss/yaml"
 	"github.com/jsdidierlaurent/echo-middleware/cache"
@@ -52,6 +56,10 @@ func (hu *httpUsecase) HTTPFormatted(params *models.HTTPFormattedParams) (*coreM
 	return hu.httpAll(api.HTTPFormattedTileType, params)
 }
 
+func (hu *httpUsecase) HTTPLatency(params *models.HTTPLatencyParams) (*coreModels.Tile, error) {
+	return hu.httpAll(api.HTTPLatencyTileType, params)
+}
+
 // httpAll handle all http usecase by checking if params match interfaces listed in coreModels.params
 func (hu *httpUsecase) httpAll(tileType coreModels.TileType, params models.GenericParamsProvider) (*coreModels.Tile, error) {
 	tile := coreModels.NewTile(tileType)
@@ -59,63 +67,126 @@ func (hu *httpUsecase) httpAll(tileType coreModels.TileType, params models.Gener
 	tile.Status = coreModels.SuccessStatus
 
 	// Download page
-	response, err := hu.get(params.GetURL())
+	response, err := hu.get(newRequest(params))
 	if err != nil {
+		var tokenErr *models.TokenError
+		if errors.As(err, &tokenErr) {
+			return nil, &coreModels.MonitororError{Err: err, Tile: tile, Message: fmt.Sprintf("unable to get oauth2 token from %s", tokenErr.TokenURL)}
+		}
 		return nil, &coreModels.MonitororError{Err: err, Tile: tile, Message: fmt.Sprintf("unable to get %s", params.GetURL())}
 	}
 
-	// Check Status Code
-	if !checkStatusCode(params, response.StatusCode) {
+	checkResponse(tileType, tile, params, response)
+	if tile.Status != coreModels.FailedStatus {
+		checkAssertions(tile, params, response)
+	}
+
+	return tile, nil
+}
+
+// checkResponse check status code and extract value of response depending of tile type
+func checkResponse(tileType coreModels.TileType, tile *coreModels.Tile, params models.GenericParamsProvider, response *models.Response) {
+	// Check Status Code, replaced by status assertions when there are some
+	if !hasStatusAssertion(params) && !checkStatusCode(params, response.StatusCode) {
 		tile.Status = coreModels.FailedStatus
 		tile.Message = fmt.Sprintf("status code %d", response.StatusCode)
-		return tile, nil
+		return
 	}
 
 	if tileType == api.HTTPStatusTileType {
-		return tile, nil
+		return
 	}
 
-	// Unmarshal page
+	if latencyParamsProvider, ok := params.(models.LatencyParamsProvider); ok {
+		checkLatency(tile, latencyParamsProvider, response.Timings)
+		return
+	}
+
+	// Unmarshal page. Body is kept unchanged for assertions
 	var content string
 	var match bool
+	body := response.Body
 
 	if formattedParamsProvider, ok := params.(models.FormattedParamsProvider); ok {
-		// Convert XML to JSON if Format == XML
-		if formattedParamsProvider.GetFormat() == models.XMLFormat {
-			buffer, err := xml2json.Convert(bytes.NewReader(response.Body))
-			if err != nil || strings.TrimSuffix(buffer.String(), "\n") == `""` {
+		switch {
+		case formattedParamsProvider.GetFormat() == models.HTMLFormat:
+			document, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+			if err != nil {
 				tile.Status = coreModels.FailedStatus
-				tile.Message = fmt.Sprintf("unable to convert xml to json")
-				return tile, nil
+				tile.Message = fmt.Sprintf("unable to parse html")
+				return
 			}
-			response.Body = buffer.Bytes()
-		}
 
-		// Select Unmarshaller
-		var unmarshaller func(data []byte, v interface{}) error
-		if formattedParamsProvider.GetFormat() == models.JSONFormat ||
-			formattedParamsProvider.GetFormat() == models.XMLFormat {
-			unmarshaller = json.Unmarshal
-		} else {
-			unmarshaller = yaml.Unmarshal
-		}
+			match, content = selectHTML(formattedParamsProvider, document)
+		case formattedParamsProvider.GetFormat() == models.CSVFormat:
+			reader := csv.NewReader(bytes.NewReader(body))
+			reader.FieldsPerRecord = -1
+			reader.TrimLeadingSpace = true
+			records, err := reader.ReadAll()
+			if err != nil {
+				tile.Status = coreModels.FailedStatus
+				tile.Message = fmt.Sprintf("unable to parse csv")
+				return
+			}
 
-		var data interface{}
-		err := unmarshaller(response.Body, &data)
-		if err != nil {
-			tile.Status = coreModels.FailedStatus
-			tile.Message = fmt.Sprintf("unable to unmarshal content")
-			return tile, nil
+			match, content = selectCSV(formattedParamsProvider, records)
+		case formattedParamsProvider.GetQueryLanguage() == models.XPathQueryLanguage:
+			// Query XML directly, without converting it to JSON
+			document, err := xmlquery.Parse(bytes.NewReader(body))
+			if err != nil {
+				tile.Status = coreModels.FailedStatus
+				tile.Message = fmt.Sprintf("unable to parse xml")
+				return
+			}
+
+			match, content = queryXPath(formattedParamsProvider, document)
+		default:
+			// Convert XML to JSON if Format == XML
+			if formattedParamsProvider.GetFormat() == models.XMLFormat {
+				buffer, err := xml2json.Convert(bytes.NewReader(body))
+				if err != nil || strings.TrimSuffix(buffer.String(), "\n") == `""` {
+					tile.Status = coreModels.FailedStatus
+					tile.Message = fmt.Sprintf("unable to convert xml to json")
+					return
+				}
+				body = buffer.Bytes()
+			}
+
+			// Select Unmarshaller
+			var unmarshaller func(data []byte, v interface{}) error
+			if formattedParamsProvider.GetFormat() == models.JSONFormat ||
+				formattedParamsProvider.GetFormat() == models.XMLFormat {
+				unmarshaller = json.Unmarshal
+			} else {
+				unmarshaller = yaml.Unmarshal
+			}
+
+			var data interface{}
+			err := unmarshaller(body, &data)
+			if err != nil {
+				tile.Status = coreModels.FailedStatus
+				tile.Message = fmt.Sprintf("unable to unmarshal content")
+				return
+			}
+
+			switch formattedParamsProvider.GetQueryLanguage() {
+			case models.JSONPathQueryLanguage:
+				match, content = queryJSONPath(formattedParamsProvider, data)
+			case models.JMESPathQueryLanguage:
+				match, content = queryJMESPath(formattedParamsProvider, data)
+			default:
+				match, content = lookupKey(formattedParamsProvider, data)
+			}
 		}
 
 		// Lookup a key
-		if match, content = lookupKey(formattedParamsProvider, data); !match {
+		if !match {
 			tile.Status = coreModels.FailedStatus
 			tile.Message = fmt.Sprintf(`unable to lookup for key %q`, formattedParamsProvider.GetKey())
-			return tile, nil
+			return
 		}
 	} else {
-		content = string(response.Body)
+		content = string(body)
 	}
 
 	// Match regex
@@ -135,24 +206,23 @@ func (hu *httpUsecase) httpAll(tileType coreModels.TileType, params models.Gener
 			tile.WithValue(coreModels.RawUnit)
 		}
 		tile.Value.Values = []string{content}
+		formatValue(tile, params)
 	}
-
-	return tile, nil
 }
 
-// Adding cache to Repository.Get
-func (hu *httpUsecase) get(url string) (*models.Response, error) {
+// Adding cache to Repository.Do
+func (hu *httpUsecase) get(request *models.Request) (*models.Response, error) {
 	response := &models.Response{}
 
 	// Lookup in cache
-	key := fmt.Sprintf("%s:%s", coreModels.UpstreamStoreKeyPrefix, url)
+	key := fmt.Sprintf("%s:%s", coreModels.UpstreamStoreKeyPrefix, request.CacheKey())
 	if err := hu.store.Get(key, response); err == nil {
 		// Cache found, return
 		return response, nil
 	}
 
 	// Download page
-	response, err := hu.repository.Get(url)
+	response, err := hu.repository.Do(request)
 	if err != nil {
 		return nil, err
 	}
@@ -163,6 +233,19 @@ func (hu *httpUsecase) get(url string) (*models.Response, error) {
 	return response, nil
 }
 
+// newRequest build request from params
+func newRequest(params models.GenericParamsProvider) *models.Request {
+	_, isLatency := params.(models.LatencyParamsProvider)
+
+	return &models.Request{
+		Method:        params.GetMethod(),
+		URL:           params.GetURL(),
+		Headers:       params.GetHeaders(),
+		Body:          params.GetBody(),
+		NewConnection: isLatency,
+	}
+}
+
 // checkStatusCode check if status code is between min / max
 // if min/max are empty, use default value
 func checkStatusCode(params models.GenericParamsProvider, code int) bool {
@@ -43,6 +43,37 @@ func (hu *httpUsecase) HTTPFormatted(params *models.HTTPFormattedParams) (tile *
 	return hu.httpAll(api.HTTPFormattedTileType, params.URL, params)
 }
 
+// HTTPLatency check status code and time of request
+func (hu *httpUsecase) HTTPLatency(params *models.HTTPLatencyParams) (tile *coreModels.Tile, err error) {
+	tile = coreModels.NewTile(api.HTTPLatencyTileType)
+	tile.Label = params.URL
+
+	tile.Status = nonempty.Struct(params.GetStatus(), hu.computeStatus(params.URL)).(coreModels.TileStatus)
+	if tile.Status == coreModels.FailedStatus {
+		tile.Message = nonempty.String(params.GetMessage(), "Fake error message")
+		return
+	}
+
+	total := time.Duration(50+rand.Intn(200)) * time.Millisecond
+	if len(params.GetValueValues()) != 0 {
+		if value, err := strconv.Atoi(params.GetValueValues()[0]); err == nil {
+			total = time.Duration(value) * time.Millisecond
+		}
+	}
+
+	checkLatency(tile, params, models.Timings{
+		DNS:          total / 10,
+		Connect:      total / 10,
+		TLSHandshake: total / 5,
+		FirstByte:    total * 4 / 5,
+		Total:        total,
+	})
+	tile.Status = nonempty.Struct(params.GetStatus(), tile.Status).(coreModels.TileStatus)
+	tile.Message = nonempty.String(params.GetMessage(), tile.Message)
+
+	return
+}
+
 // httpAll handle all http usecase by checking if params match interfaces listed in coreModels.params
 func (hu *httpUsecase) httpAll(tileType coreModels.TileType, url string, params models.FakerParamsProvider) (tile *coreModels.Tile, err error) {
 	tile = coreModels.NewTile(tileType)
@@ -74,6 +105,7 @@ func (hu *httpUsecase) httpAll(tileType coreModels.TileType, url string, params
 		}
 
 		tile.Value.Values = values
+		formatValue(tile, params)
 	}
 
 	if tile.Status == coreModels.FailedStatus {
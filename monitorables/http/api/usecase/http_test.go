@@ -3,6 +3,8 @@ package usecase
 import (
 	"context"
 	"encoding/json"
+	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,6 +14,9 @@ import (
 	"github.com/monitoror/monitoror/monitorables/http/api/models"
 
 	"github.com/AlekSi/pointer"
+	"github.com/PuerkitoBio/goquery"
+	"github.com/antchfx/xmlquery"
+	ghodssYaml "github.com/ghodss/yaml"
 	"github.com/jsdidierlaurent/echo-middleware/cache"
 	"github.com/stretchr/testify/assert"
 	. "github.com/stretchr/testify/mock"
@@ -20,13 +25,28 @@ import (
 
 func TestHTTPStatus_WithError(t *testing.T) {
 	mockRepository := new(mocks.Repository)
-	mockRepository.On("Get", AnythingOfType("string")).Return(nil, context.DeadlineExceeded)
+	mockRepository.On("Do", AnythingOfType("*models.Request")).Return(nil, context.DeadlineExceeded)
 	tu := NewHTTPUsecase(mockRepository, cache.NewGoCacheStore(time.Minute*5, time.Second), 2000)
 
 	tile, err := tu.HTTPStatus(&models.HTTPStatusParams{URL: "toto"})
 	if assert.Error(t, err) {
 		assert.Nil(t, tile)
-		mockRepository.AssertNumberOfCalls(t, "Get", 1)
+		mockRepository.AssertNumberOfCalls(t, "Do", 1)
+		mockRepository.AssertExpectations(t)
+	}
+}
+
+func TestHTTPStatus_WithTokenError(t *testing.T) {
+	mockRepository := new(mocks.Repository)
+	mockRepository.On("Do", AnythingOfType("*models.Request")).
+		Return(nil, &models.TokenError{TokenURL: "http://auth.example.com/token", Err: context.DeadlineExceeded})
+	tu := NewHTTPUsecase(mockRepository, cache.NewGoCacheStore(time.Minute*5, time.Second), 2000)
+
+	tile, err := tu.HTTPStatus(&models.HTTPStatusParams{URL: "toto"})
+	if assert.Error(t, err) {
+		assert.Nil(t, tile)
+		assert.Equal(t, "unable to get oauth2 token from http://auth.example.com/token", err.Error())
+		mockRepository.AssertNumberOfCalls(t, "Do", 1)
 		mockRepository.AssertExpectations(t)
 	}
 }
@@ -103,6 +123,14 @@ func TestHtmlAll_WithoutErrors(t *testing.T) {
 			},
 			expectedStatus: coreModels.SuccessStatus, expectedLabel: "toto", expectedValueUnit: coreModels.NumberUnit, expectedValueValues: []string{"123456789"},
 		},
+		{
+			// HTTP Json with unit
+			body: `{"key": 2048 }`,
+			usecaseFunc: func(usecase api.Usecase) (*coreModels.Tile, error) {
+				return usecase.HTTPFormatted(&models.HTTPFormattedParams{URL: "toto", Format: models.JSONFormat, Key: "key", Unit: coreModels.BinaryBytesUnit})
+			},
+			expectedStatus: coreModels.SuccessStatus, expectedLabel: "toto", expectedValueUnit: coreModels.BinaryBytesUnit, expectedValueValues: []string{"2048"},
+		},
 		{
 			// HTTP Json missing key
 			body: `{"key": "value"}`,
@@ -135,6 +163,78 @@ func TestHtmlAll_WithoutErrors(t *testing.T) {
 			},
 			expectedStatus: coreModels.FailedStatus, expectedLabel: "toto", expectedMessage: "unable to convert xml to json",
 		},
+		{
+			// HTTP XML with XPath
+			body: `<nodes><node healthy="true"/><node healthy="false"/><node healthy="false"/></nodes>`,
+			usecaseFunc: func(usecase api.Usecase) (*coreModels.Tile, error) {
+				return usecase.HTTPFormatted(&models.HTTPFormattedParams{URL: "toto", Format: models.XMLFormat, Key: "count(//node[@healthy='false'])", QueryLanguage: models.XPathQueryLanguage})
+			},
+			expectedStatus: coreModels.SuccessStatus, expectedLabel: "toto", expectedValueUnit: coreModels.NumberUnit, expectedValueValues: []string{"2"},
+		},
+		{
+			// HTTP XML with XPath, unable to parse
+			body: `<check><status test="2">OK</stat`,
+			usecaseFunc: func(usecase api.Usecase) (*coreModels.Tile, error) {
+				return usecase.HTTPFormatted(&models.HTTPFormattedParams{URL: "toto", Format: models.XMLFormat, Key: "/check/status", QueryLanguage: models.XPathQueryLanguage})
+			},
+			expectedStatus: coreModels.FailedStatus, expectedLabel: "toto", expectedMessage: "unable to parse xml",
+		},
+		{
+			// HTTP JSON with JSONPath
+			body: `{"nodes": [{"healthy": true}, {"healthy": false}, {"healthy": false}]}`,
+			usecaseFunc: func(usecase api.Usecase) (*coreModels.Tile, error) {
+				return usecase.HTTPFormatted(&models.HTTPFormattedParams{URL: "toto", Format: models.JSONFormat, Key: "length($.nodes[?(@.healthy == false)])", QueryLanguage: models.JSONPathQueryLanguage})
+			},
+			expectedStatus: coreModels.SuccessStatus, expectedLabel: "toto", expectedValueUnit: coreModels.NumberUnit, expectedValueValues: []string{"2"},
+		},
+		{
+			// HTTP YAML with JMESPath
+			body: "nodes:\n  - healthy: true\n  - healthy: false\n",
+			usecaseFunc: func(usecase api.Usecase) (*coreModels.Tile, error) {
+				return usecase.HTTPFormatted(&models.HTTPFormattedParams{URL: "toto", Format: models.YAMLFormat, Key: "length(nodes[?healthy == `false`])", QueryLanguage: models.JMESPathQueryLanguage})
+			},
+			expectedStatus: coreModels.SuccessStatus, expectedLabel: "toto", expectedValueUnit: coreModels.NumberUnit, expectedValueValues: []string{"1"},
+		},
+		{
+			// HTTP JSON with JMESPath without match
+			body: `{"nodes": []}`,
+			usecaseFunc: func(usecase api.Usecase) (*coreModels.Tile, error) {
+				return usecase.HTTPFormatted(&models.HTTPFormattedParams{URL: "toto", Format: models.JSONFormat, Key: "cluster.name", QueryLanguage: models.JMESPathQueryLanguage})
+			},
+			expectedStatus: coreModels.FailedStatus, expectedLabel: "toto", expectedMessage: `unable to lookup for key "cluster.name"`,
+		},
+		{
+			// HTTP HTML with CSS selector
+			body: `<html><body><table><tr class="failed"><td>job1</td></tr><tr><td>job2</td></tr><tr class="failed"><td>job3</td></tr></table></body></html>`,
+			usecaseFunc: func(usecase api.Usecase) (*coreModels.Tile, error) {
+				return usecase.HTTPFormatted(&models.HTTPFormattedParams{URL: "toto", Format: models.HTMLFormat, Key: "tr.failed", Aggregate: models.CountAggregate})
+			},
+			expectedStatus: coreModels.SuccessStatus, expectedLabel: "toto", expectedValueUnit: coreModels.NumberUnit, expectedValueValues: []string{"2"},
+		},
+		{
+			// HTTP HTML without match
+			body: `<html><body><span id="version">1.2.0</span></body></html>`,
+			usecaseFunc: func(usecase api.Usecase) (*coreModels.Tile, error) {
+				return usecase.HTTPFormatted(&models.HTTPFormattedParams{URL: "toto", Format: models.HTMLFormat, Key: "#status"})
+			},
+			expectedStatus: coreModels.FailedStatus, expectedLabel: "toto", expectedMessage: `unable to lookup for key "#status"`,
+		},
+		{
+			// HTTP CSV with aggregate
+			body: "date,errors\n2020-01-01,3\n2020-01-02,5\n",
+			usecaseFunc: func(usecase api.Usecase) (*coreModels.Tile, error) {
+				return usecase.HTTPFormatted(&models.HTTPFormattedParams{URL: "toto", Format: models.CSVFormat, Key: "errors", Aggregate: models.SumAggregate})
+			},
+			expectedStatus: coreModels.SuccessStatus, expectedLabel: "toto", expectedValueUnit: coreModels.NumberUnit, expectedValueValues: []string{"8"},
+		},
+		{
+			// HTTP CSV, unable to parse
+			body: "date,errors\n\"2020-01-01,3\n",
+			usecaseFunc: func(usecase api.Usecase) (*coreModels.Tile, error) {
+				return usecase.HTTPFormatted(&models.HTTPFormattedParams{URL: "toto", Format: models.CSVFormat, Key: "errors"})
+			},
+			expectedStatus: coreModels.FailedStatus, expectedLabel: "toto", expectedMessage: "unable to parse csv",
+		},
 		{
 			// HTTP YAML
 			body: "key: value",
@@ -145,7 +245,7 @@ func TestHtmlAll_WithoutErrors(t *testing.T) {
 		},
 	} {
 		mockRepository := new(mocks.Repository)
-		mockRepository.On("Get", AnythingOfType("string")).
+		mockRepository.On("Do", AnythingOfType("*models.Request")).
 			Return(&models.Response{StatusCode: 200, Body: []byte(testcase.body)}, nil)
 		tu := NewHTTPUsecase(mockRepository, cache.NewGoCacheStore(time.Minute*5, time.Second), 2000)
 
@@ -158,7 +258,7 @@ func TestHtmlAll_WithoutErrors(t *testing.T) {
 				assert.Equal(t, testcase.expectedValueUnit, tile.Value.Unit)
 				assert.Equal(t, testcase.expectedValueValues, tile.Value.Values)
 			}
-			mockRepository.AssertNumberOfCalls(t, "Get", 1)
+			mockRepository.AssertNumberOfCalls(t, "Do", 1)
 			mockRepository.AssertExpectations(t)
 		}
 	}
@@ -166,7 +266,7 @@ func TestHtmlAll_WithoutErrors(t *testing.T) {
 
 func TestHTTPStatus_WithCache(t *testing.T) {
 	mockRepository := new(mocks.Repository)
-	mockRepository.On("Get", AnythingOfType("string")).
+	mockRepository.On("Do", AnythingOfType("*models.Request")).
 		Return(&models.Response{StatusCode: 200, Body: []byte("test with cache")}, nil)
 
 	tu := NewHTTPUsecase(mockRepository, cache.NewGoCacheStore(time.Minute*5, time.Second), 2000)
@@ -182,10 +282,102 @@ func TestHTTPStatus_WithCache(t *testing.T) {
 		assert.Equal(t, "toto", tile.Label)
 		assert.Equal(t, "test with cache", tile.Value.Values[0])
 	}
-	mockRepository.AssertNumberOfCalls(t, "Get", 1)
+	mockRepository.AssertNumberOfCalls(t, "Do", 1)
 	mockRepository.AssertExpectations(t)
 }
 
+func TestHTTPStatus_WithRequest(t *testing.T) {
+	expectedRequest := &models.Request{
+		Method:  "POST",
+		URL:     "toto",
+		Headers: map[string]string{"Content-Type": "application/json"},
+		Body:    `{"query": "{ health }"}`,
+	}
+
+	mockRepository := new(mocks.Repository)
+	mockRepository.On("Do", expectedRequest).Return(&models.Response{StatusCode: 200}, nil)
+	mockRepository.On("Do", &models.Request{Method: "GET", URL: "toto"}).Return(&models.Response{StatusCode: 405}, nil)
+	tu := NewHTTPUsecase(mockRepository, cache.NewGoCacheStore(time.Minute*5, time.Second), 2000)
+
+	tile, err := tu.HTTPStatus(&models.HTTPStatusParams{
+		URL:     "toto",
+		Method:  "POST",
+		Headers: []string{"Content-Type: application/json"},
+		Body:    `{"query": "{ health }"}`,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, coreModels.SuccessStatus, tile.Status)
+	}
+
+	// Same URL with another method doesn't share cache
+	tile, err = tu.HTTPStatus(&models.HTTPStatusParams{URL: "toto"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, coreModels.FailedStatus, tile.Status)
+	}
+
+	mockRepository.AssertNumberOfCalls(t, "Do", 2)
+}
+
+func TestHTTPLatency(t *testing.T) {
+	timings := models.Timings{
+		DNS:          5 * time.Millisecond,
+		Connect:      10 * time.Millisecond,
+		TLSHandshake: 20 * time.Millisecond,
+		FirstByte:    250 * time.Millisecond,
+		Total:        300 * time.Millisecond,
+	}
+
+	for _, testcase := range []struct {
+		params          *models.HTTPLatencyParams
+		statusCode      int
+		expectedStatus  coreModels.TileStatus
+		expectedMessage string
+		expectedValue   *coreModels.TileValue
+	}{
+		{
+			params:          &models.HTTPLatencyParams{URL: "toto"},
+			statusCode:      200,
+			expectedStatus:  coreModels.SuccessStatus,
+			expectedMessage: "dns 5ms, connect 10ms, tls 20ms, ttfb 250ms",
+			expectedValue:   &coreModels.TileValue{Unit: coreModels.MillisecondUnit, Values: []string{"300"}},
+		},
+		{
+			params:          &models.HTTPLatencyParams{URL: "toto", WarningLatency: pointer.ToInt(200), FailureLatency: pointer.ToInt(500)},
+			statusCode:      200,
+			expectedStatus:  coreModels.WarningStatus,
+			expectedMessage: "dns 5ms, connect 10ms, tls 20ms, ttfb 250ms",
+			expectedValue:   &coreModels.TileValue{Unit: coreModels.MillisecondUnit, Values: []string{"300"}},
+		},
+		{
+			params:          &models.HTTPLatencyParams{URL: "toto", WarningLatency: pointer.ToInt(100), FailureLatency: pointer.ToInt(300)},
+			statusCode:      200,
+			expectedStatus:  coreModels.FailedStatus,
+			expectedMessage: "dns 5ms, connect 10ms, tls 20ms, ttfb 250ms",
+			expectedValue:   &coreModels.TileValue{Unit: coreModels.MillisecondUnit, Values: []string{"300"}},
+		},
+		{
+			params:          &models.HTTPLatencyParams{URL: "toto"},
+			statusCode:      500,
+			expectedStatus:  coreModels.FailedStatus,
+			expectedMessage: "status code 500",
+		},
+	} {
+		mockRepository := new(mocks.Repository)
+		mockRepository.On("Do", &models.Request{Method: "GET", URL: "toto", NewConnection: true}).
+			Return(&models.Response{StatusCode: testcase.statusCode, Timings: timings}, nil)
+		tu := NewHTTPUsecase(mockRepository, cache.NewGoCacheStore(time.Minute*5, time.Second), 2000)
+
+		tile, err := tu.HTTPLatency(testcase.params)
+		if assert.NoError(t, err) {
+			assert.Equal(t, api.HTTPLatencyTileType, tile.Type)
+			assert.Equal(t, testcase.expectedStatus, tile.Status)
+			assert.Equal(t, testcase.expectedMessage, tile.Message)
+			assert.Equal(t, testcase.expectedValue, tile.Value)
+			mockRepository.AssertExpectations(t)
+		}
+	}
+}
+
 func TestHTTPUsecase_CheckStatusCode(t *testing.T) {
 	httpAny := &models.HTTPStatusParams{}
 	assert.True(t, checkStatusCode(httpAny, 301))
@@ -219,6 +411,23 @@ func TestHTTPUsecase_Match(t *testing.T) {
 	assert.Equal(t, "", substring)
 }
 
+func TestHTTPUsecase_FormatValue(t *testing.T) {
+	tile := coreModels.NewTile(api.HTTPRawTileType).WithValue(coreModels.NumberUnit)
+	tile.Value.Values = []string{"12.5"}
+	formatValue(tile, &models.HTTPRawParams{Unit: coreModels.CurrencyUnit, Currency: "EUR", Precision: pointer.ToInt(1), Prefix: "~"})
+	assert.Equal(t, coreModels.CurrencyUnit, tile.Value.Unit)
+	assert.Equal(t, "EUR", tile.Value.Currency)
+	assert.Equal(t, pointer.ToInt(1), tile.Value.Precision)
+	assert.Equal(t, "~", tile.Value.Prefix)
+
+	tile = coreModels.NewTile(api.HTTPRawTileType).WithValue(coreModels.RawUnit)
+	tile.Value.Values = []string{"value"}
+	formatValue(tile, &models.HTTPRawParams{Unit: coreModels.PercentUnit, Suffix: " used"})
+	assert.Equal(t, coreModels.RawUnit, tile.Value.Unit)
+	assert.Equal(t, " used", tile.Value.Suffix)
+	assert.Empty(t, tile.Value.Currency)
+}
+
 func TestHTTPUsecase_LookupKey_Json(t *testing.T) {
 	input := `
 {
@@ -283,3 +492,256 @@ bloc1:
 		assert.False(t, found)
 	}
 }
+
+func TestHTTPUsecase_QueryJSONPath(t *testing.T) {
+	input := `
+{
+	"nodes": [
+		{ "name": "node1", "healthy": true, "load": 0.5 },
+		{ "name": "node2", "healthy": false, "load": 1.5 },
+		{ "name": "node3", "healthy": false, "load": 2 }
+	]
+}
+`
+	var data interface{}
+	if !assert.NoError(t, json.Unmarshal([]byte(input), &data)) {
+		return
+	}
+
+	for _, testcase := range []struct {
+		query         string
+		expectedFound bool
+		expectedValue string
+	}{
+		{query: "$.nodes[0].name", expectedFound: true, expectedValue: "node1"},
+		{query: "$.nodes[*].name", expectedFound: true, expectedValue: `["node1","node2","node3"]`},
+		{query: "$.nodes[?(@.healthy == false)].name", expectedFound: true, expectedValue: `["node2","node3"]`},
+		{query: "length($.nodes[?(@.healthy == false)])", expectedFound: true, expectedValue: "2"},
+		{query: "sum($.nodes[*].load)", expectedFound: true, expectedValue: "4"},
+		{query: "sum($.nodes[*].name)", expectedFound: false},
+		{query: "$.cluster", expectedFound: false},
+	} {
+		found, value := queryJSONPath(&models.HTTPFormattedParams{Key: testcase.query}, data)
+		assert.Equal(t, testcase.expectedFound, found, testcase.query)
+		assert.Equal(t, testcase.expectedValue, value, testcase.query)
+	}
+}
+
+func TestHTTPUsecase_QueryJMESPath(t *testing.T) {
+	input := `
+nodes:
+  - name: node1
+    healthy: true
+    load: 0.5
+  - name: node2
+    healthy: false
+    load: 1.5
+`
+	var data interface{}
+	if !assert.NoError(t, ghodssYaml.Unmarshal([]byte(input), &data)) {
+		return
+	}
+
+	for _, testcase := range []struct {
+		query         string
+		expectedFound bool
+		expectedValue string
+	}{
+		{query: "nodes[0].name", expectedFound: true, expectedValue: "node1"},
+		{query: "nodes[*].name", expectedFound: true, expectedValue: `["node1","node2"]`},
+		{query: "nodes[?healthy == `false`].name | [0]", expectedFound: true, expectedValue: "node2"},
+		{query: "sum(nodes[*].load)", expectedFound: true, expectedValue: "2"},
+		{query: "cluster", expectedFound: false},
+	} {
+		found, value := queryJMESPath(&models.HTTPFormattedParams{Key: testcase.query}, data)
+		assert.Equal(t, testcase.expectedFound, found, testcase.query)
+		assert.Equal(t, testcase.expectedValue, value, testcase.query)
+	}
+}
+
+func TestHTTPUsecase_QueryXPath(t *testing.T) {
+	input := `<cluster name="prod"><node load="0.5">node1</node><node load="1.5">node2</node></cluster>`
+
+	document, err := xmlquery.Parse(strings.NewReader(input))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	for _, testcase := range []struct {
+		query         string
+		expectedFound bool
+		expectedValue string
+	}{
+		{query: "/cluster/@name", expectedFound: true, expectedValue: "prod"},
+		{query: "/cluster/node[2]", expectedFound: true, expectedValue: "node2"},
+		{query: "/cluster/node", expectedFound: true, expectedValue: `["node1","node2"]`},
+		{query: "sum(//node/@load)", expectedFound: true, expectedValue: "2"},
+		{query: "count(//node)", expectedFound: true, expectedValue: "2"},
+		{query: "/cluster/missing", expectedFound: false},
+	} {
+		found, value := queryXPath(&models.HTTPFormattedParams{Key: testcase.query}, document)
+		assert.Equal(t, testcase.expectedFound, found, testcase.query)
+		assert.Equal(t, testcase.expectedValue, value, testcase.query)
+	}
+}
+
+func TestHTTPUsecase_SelectHTML(t *testing.T) {
+	input := `
+<html>
+	<body>
+		<div id="status">OK</div>
+		<ul class="queues">
+			<li><span class="name">mail</span> <span class="size">12</span></li>
+			<li><span class="name">sms</span> <span class="size">3</span></li>
+			<li><span class="name">push</span> <span class="size">n/a</span></li>
+		</ul>
+	</body>
+</html>
+`
+	document, err := goquery.NewDocumentFromReader(strings.NewReader(input))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	for _, testcase := range []struct {
+		selector      string
+		aggregate     models.Aggregate
+		expectedFound bool
+		expectedValue string
+	}{
+		{selector: "#status", expectedFound: true, expectedValue: "OK"},
+		{selector: ".queues .name", expectedFound: true, expectedValue: "mail"},
+		{selector: ".queues .name", aggregate: models.LastAggregate, expectedFound: true, expectedValue: "push"},
+		{selector: ".queues li", aggregate: models.CountAggregate, expectedFound: true, expectedValue: "3"},
+		{selector: ".queues .size", aggregate: models.SumAggregate, expectedFound: true, expectedValue: "15"},
+		{selector: ".queues .size", aggregate: models.MaxAggregate, expectedFound: true, expectedValue: "12"},
+		{selector: ".queues .name", aggregate: models.SumAggregate, expectedFound: false},
+		{selector: "#missing", aggregate: models.CountAggregate, expectedFound: true, expectedValue: "0"},
+		{selector: "#missing", expectedFound: false},
+	} {
+		found, value := selectHTML(&models.HTTPFormattedParams{Key: testcase.selector, Aggregate: testcase.aggregate}, document)
+		assert.Equal(t, testcase.expectedFound, found, testcase.selector)
+		assert.Equal(t, testcase.expectedValue, value, testcase.selector)
+	}
+}
+
+func TestHTTPUsecase_SelectCSV(t *testing.T) {
+	records := [][]string{
+		{"date", "errors", "duration"},
+		{"2020-01-01", "3", "1.5"},
+		{"2020-01-02", "5", "2.5"},
+		{"2020-01-03", "", "0.5"},
+	}
+
+	for _, testcase := range []struct {
+		key           string
+		row           *int
+		aggregate     models.Aggregate
+		expectedFound bool
+		expectedValue string
+	}{
+		{key: "date", expectedFound: true, expectedValue: "2020-01-01"},
+		{key: "date", aggregate: models.LastAggregate, expectedFound: true, expectedValue: "2020-01-03"},
+		{key: "date", row: pointer.ToInt(1), expectedFound: true, expectedValue: "2020-01-02"},
+		{key: "date", row: pointer.ToInt(3), expectedFound: false},
+		{key: "1", aggregate: models.SumAggregate, expectedFound: true, expectedValue: "8"},
+		{key: "errors", aggregate: models.CountAggregate, expectedFound: true, expectedValue: "3"},
+		{key: "duration", aggregate: models.AverageAggregate, expectedFound: true, expectedValue: "1.5"},
+		{key: "duration", aggregate: models.MinAggregate, expectedFound: true, expectedValue: "0.5"},
+		{key: "status", expectedFound: false},
+		{key: "3", expectedFound: false},
+	} {
+		found, value := selectCSV(&models.HTTPFormattedParams{Key: testcase.key, Row: testcase.row, Aggregate: testcase.aggregate}, records)
+		assert.Equal(t, testcase.expectedFound, found, testcase.key)
+		assert.Equal(t, testcase.expectedValue, value, testcase.key)
+	}
+}
+
+func TestHTTPUsecase_Assertions(t *testing.T) {
+	expiry := time.Now().Add(10*24*time.Hour + time.Hour)
+	response := &models.Response{
+		StatusCode:        200,
+		Header:            http.Header{"Content-Type": []string{"application/json"}},
+		Body:              []byte(`{"status": "UP", "queue": {"size": 42}}`),
+		CertificateExpiry: &expiry,
+	}
+
+	for _, testcase := range []struct {
+		params          models.GenericParamsProvider
+		expectedStatus  coreModels.TileStatus
+		expectedMessage string
+	}{
+		{
+			params: &models.HTTPStatusParams{URL: "toto", Assertions: []string{
+				"status between 200 299", "header:Content-Type contains json", "body matches \"status\": \"UP\"",
+				"json:status == UP", "json:queue.size < 100", "size <= 1024", "certificateDays == 10",
+			}},
+			expectedStatus: coreModels.SuccessStatus,
+		},
+		{
+			params:          &models.HTTPStatusParams{URL: "toto", Assertions: []string{"status == 200", "WARNING json:queue.size < 10", "WARNING certificateDays > 14"}},
+			expectedStatus:  coreModels.WarningStatus,
+			expectedMessage: "failed assertions: WARNING json:queue.size < 10, WARNING certificateDays > 14",
+		},
+		{
+			params:          &models.HTTPStatusParams{URL: "toto", Assertions: []string{"WARNING json:queue.size < 10", "header:X-Version == 2", "json:missing != 0"}},
+			expectedStatus:  coreModels.FailedStatus,
+			expectedMessage: "failed assertions: WARNING json:queue.size < 10, header:X-Version == 2, json:missing != 0",
+		},
+		{
+			params:          &models.HTTPStatusParams{URL: "toto", StatusCodeMin: pointer.ToInt(400), Assertions: []string{"WARNING size > 1024"}},
+			expectedStatus:  coreModels.FailedStatus,
+			expectedMessage: "status code 200",
+		},
+		{
+			// Status assertions replace statusCodeMin / statusCodeMax
+			params:         &models.HTTPStatusParams{URL: "toto", StatusCodeMin: pointer.ToInt(400), Assertions: []string{"status == 200"}},
+			expectedStatus: coreModels.SuccessStatus,
+		},
+		{
+			params:          &models.HTTPFormattedParams{URL: "toto", Format: models.JSONFormat, Key: "queue.size", Assertions: []string{"FAILURE header:Content-Type == text/html"}},
+			expectedStatus:  coreModels.FailedStatus,
+			expectedMessage: "failed assertions: FAILURE header:Content-Type == text/html",
+		},
+	} {
+		mockRepository := new(mocks.Repository)
+		mockRepository.On("Do", AnythingOfType("*models.Request")).Return(response, nil)
+		tu := NewHTTPUsecase(mockRepository, cache.NewGoCacheStore(time.Minute*5, time.Second), 2000)
+
+		var tile *coreModels.Tile
+		var err error
+		switch params := testcase.params.(type) {
+		case *models.HTTPStatusParams:
+			tile, err = tu.HTTPStatus(params)
+		case *models.HTTPFormattedParams:
+			tile, err = tu.HTTPFormatted(params)
+		}
+
+		if assert.NoError(t, err) {
+			assert.Equal(t, testcase.expectedStatus, tile.Status)
+			assert.Equal(t, testcase.expectedMessage, tile.Message)
+		}
+	}
+}
+
+func TestHTTPUsecase_Assertions_WithXMLAndStatus(t *testing.T) {
+	response := &models.Response{
+		StatusCode: 503,
+		Body:       []byte(`<health><status>MAINTENANCE</status></health>`),
+	}
+
+	mockRepository := new(mocks.Repository)
+	mockRepository.On("Do", AnythingOfType("*models.Request")).Return(response, nil)
+	tu := NewHTTPUsecase(mockRepository, cache.NewGoCacheStore(time.Minute*5, time.Second), 2000)
+
+	// Assertions see the original XML body, not the body converted to JSON
+	tile, err := tu.HTTPFormatted(&models.HTTPFormattedParams{
+		URL: "toto", Format: models.XMLFormat, Key: "health.status",
+		Assertions: []string{"status == 503", "body contains <status>MAINTENANCE</status>", "size == 45"},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, coreModels.SuccessStatus, tile.Status)
+		assert.Empty(t, tile.Message)
+		assert.Equal(t, []string{"MAINTENANCE"}, tile.Value.Values)
+	}
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/http/api/models"
+)
+
+// checkLatency set total time of request as tile value, with details of timings in message.
+// Status is changed when total time reach latencies requested in params
+func checkLatency(tile *coreModels.Tile, params models.LatencyParamsProvider, timings models.Timings) {
+	total := timings.Total.Milliseconds()
+
+	tile.WithValue(coreModels.MillisecondUnit)
+	tile.Value.Values = []string{strconv.FormatInt(total, 10)}
+
+	var details []string
+	for _, timing := range []struct {
+		name     string
+		duration time.Duration
+	}{
+		{"dns", timings.DNS},
+		{"connect", timings.Connect},
+		{"tls", timings.TLSHandshake},
+		{"ttfb", timings.FirstByte},
+	} {
+		if timing.duration > 0 {
+			details = append(details, fmt.Sprintf("%s %dms", timing.name, timing.duration.Milliseconds()))
+		}
+	}
+	tile.Message = strings.Join(details, ", ")
+
+	warning, failure := params.GetLatencies()
+	if failure != nil && total >= int64(*failure) {
+		tile.Status = coreModels.FailedStatus
+	} else if warning != nil && total >= int64(*warning) {
+		tile.Status = coreModels.WarningStatus
+	}
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/monitoror/monitoror/monitorables/http/api/models"
+	"github.com/monitoror/monitoror/pkg/humanize"
+
+	"github.com/antchfx/xmlquery"
+	"github.com/antchfx/xpath"
+)
+
+// queryJSONPath evaluate JSONPath query of params on data (json/yaml/...)
+func queryJSONPath(params models.FormattedParamsProvider, data interface{}) (bool, string) {
+	query, err := models.NewJSONPath(params.GetKey())
+	if err != nil {
+		return false, ""
+	}
+
+	result, err := query(context.Background(), data)
+	if err != nil {
+		return false, ""
+	}
+
+	return humanizeQueryResult(result)
+}
+
+// queryJMESPath evaluate JMESPath query of params on data (json/yaml/...)
+func queryJMESPath(params models.FormattedParamsProvider, data interface{}) (bool, string) {
+	query, err := models.NewJMESPath(params.GetKey())
+	if err != nil {
+		return false, ""
+	}
+
+	result, err := query.Search(data)
+	if err != nil {
+		return false, ""
+	}
+
+	return humanizeQueryResult(result)
+}
+
+// queryXPath evaluate XPath query of params on xml document.
+// Selected nodes are replaced by their text
+func queryXPath(params models.FormattedParamsProvider, document *xmlquery.Node) (bool, string) {
+	query, err := models.NewXPath(params.GetKey())
+	if err != nil {
+		return false, ""
+	}
+
+	result := query.Evaluate(xmlquery.CreateXPathNavigator(document))
+	if iterator, ok := result.(*xpath.NodeIterator); ok {
+		var texts []interface{}
+		for iterator.MoveNext() {
+			texts = append(texts, iterator.Current().Value())
+		}
+
+		switch len(texts) {
+		case 0:
+			return false, ""
+		case 1:
+			return true, texts[0].(string)
+		default:
+			result = texts
+		}
+	}
+
+	return humanizeQueryResult(result)
+}
+
+// humanizeQueryResult transform result of query to string, arrays and objects are kept in JSON
+func humanizeQueryResult(result interface{}) (bool, string) {
+	switch result.(type) {
+	case nil:
+		return false, ""
+	case []interface{}, map[string]interface{}:
+		bytes, err := json.Marshal(result)
+		if err != nil {
+			return false, ""
+		}
+		return true, string(bytes)
+	default:
+		return true, humanize.Interface(result)
+	}
+}
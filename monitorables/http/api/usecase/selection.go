@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/monitoror/monitoror/monitorables/http/api/models"
+	"github.com/monitoror/monitoror/pkg/humanize"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// selectHTML select text of elements matching the css selector and aggregate them
+func selectHTML(params models.FormattedParamsProvider, document *goquery.Document) (bool, string) {
+	selector, err := models.NewCSSSelector(params.GetKey())
+	if err != nil {
+		return false, ""
+	}
+
+	values := document.FindMatcher(selector).Map(func(_ int, selection *goquery.Selection) string {
+		return strings.TrimSpace(selection.Text())
+	})
+
+	return aggregate(params.GetAggregate(), values)
+}
+
+// selectCSV select values of a column and return the selected row or aggregate them
+// the first record is the header, the key is either a column name or a zero-based column index
+func selectCSV(params models.FormattedParamsProvider, records [][]string) (bool, string) {
+	if len(records) == 0 {
+		return false, ""
+	}
+
+	column := -1
+	for i, name := range records[0] {
+		if strings.TrimSpace(name) == params.GetKey() {
+			column = i
+			break
+		}
+	}
+	if column == -1 {
+		if index, err := strconv.Atoi(params.GetKey()); err == nil && index >= 0 && index < len(records[0]) {
+			column = index
+		} else {
+			return false, ""
+		}
+	}
+
+	var values []string
+	for _, record := range records[1:] {
+		value := ""
+		if column < len(record) {
+			value = strings.TrimSpace(record[column])
+		}
+		values = append(values, value)
+	}
+
+	if row := params.GetRow(); row != nil {
+		if *row >= len(values) {
+			return false, ""
+		}
+		return true, values[*row]
+	}
+
+	return aggregate(params.GetAggregate(), values)
+}
+
+// aggregate reduce values to a single one, numeric aggregates ignore values that are not numbers
+func aggregate(aggregate models.Aggregate, values []string) (bool, string) {
+	if aggregate == models.CountAggregate {
+		return true, strconv.Itoa(len(values))
+	}
+
+	if len(values) == 0 {
+		return false, ""
+	}
+
+	switch aggregate {
+	case models.LastAggregate:
+		return true, values[len(values)-1]
+	case models.SumAggregate, models.AverageAggregate, models.MinAggregate, models.MaxAggregate:
+		var numbers []float64
+		for _, value := range values {
+			if number, err := strconv.ParseFloat(value, 64); err == nil {
+				numbers = append(numbers, number)
+			}
+		}
+		if len(numbers) == 0 {
+			return false, ""
+		}
+
+		result := numbers[0]
+		for _, number := range numbers[1:] {
+			switch aggregate {
+			case models.SumAggregate, models.AverageAggregate:
+				result += number
+			case models.MinAggregate:
+				if number < result {
+					result = number
+				}
+			case models.MaxAggregate:
+				if number > result {
+					result = number
+				}
+			}
+		}
+		if aggregate == models.AverageAggregate {
+			result /= float64(len(numbers))
+		}
+
+		return true, humanize.Interface(result)
+	default:
+		return true, values[0]
+	}
+}
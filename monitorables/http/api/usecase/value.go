@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"strconv"
+
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/http/api/models"
+)
+
+// formatValue apply unit and formatting options requested in params on tile value.
+// Numeric units are ignored when value isn't a number
+func formatValue(tile *coreModels.Tile, params interface{}) {
+	valueFormatParamsProvider, ok := params.(models.ValueFormatParamsProvider)
+	if !ok || tile.Value == nil {
+		return
+	}
+
+	if unit := valueFormatParamsProvider.GetUnit(); unit != "" {
+		isNumber := true
+		for _, value := range tile.Value.Values {
+			if _, err := strconv.ParseFloat(value, 64); err != nil {
+				isNumber = false
+			}
+		}
+
+		if isNumber || unit == coreModels.RawUnit {
+			tile.Value.Unit = unit
+		}
+	}
+
+	tile.Value.Precision = valueFormatParamsProvider.GetPrecision()
+	tile.Value.Prefix = valueFormatParamsProvider.GetPrefix()
+	tile.Value.Suffix = valueFormatParamsProvider.GetSuffix()
+	if tile.Value.Unit == coreModels.CurrencyUnit {
+		tile.Value.Currency = valueFormatParamsProvider.GetCurrency()
+	}
+}
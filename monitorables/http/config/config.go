@@ -1,13 +1,197 @@
 package config
 
+import (
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/monitoror/monitoror/pkg/certpool"
+
+	"golang.org/x/net/http/httpproxy"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
 type (
 	HTTP struct {
 		Timeout   int `validate:"gte=0"` // In Millisecond
 		SSLVerify bool
+
+		// Headers added to every request of the variant, as JSON object (ex: {"X-Api-Version": "2"})
+		Headers string
+
+		// Credentials added to every request of the variant, kept out of the dashboard config
+		Username     string // Basic auth
+		Password     string // Basic auth
+		Token        string // Bearer token
+		APIKey       string // API key, sent in APIKeyHeader
+		APIKeyHeader string `validate:"required"`
+		// CredentialsURLPrefixes is a comma separated list of URL prefixes credentials are sent to (ex: https://api.example.com/v2)
+		// Required with credentials, other URLs are requested without credentials
+		CredentialsURLPrefixes string
+
+		// Proxy used for every request of the variant (ex: http://proxy.example.com:3128)
+		Proxy string
+		// NoProxy is a comma separated list of hosts requested without proxy (ex: localhost,.example.com)
+		NoProxy string
+
+		// Paths of PEM files used for mutual TLS
+		ClientCert string
+		ClientKey  string
+		// CACert is the path of a PEM bundle trusted in addition to system certificates
+		CACert string
+
+		MaxRedirects int `validate:"gte=0"` // 0 to never follow redirects
+		HTTP2        bool
+
+		// OAuth2 client credentials, access token is fetched from OAuth2TokenURL and sent as Bearer token
+		OAuth2TokenURL     string
+		OAuth2ClientID     string
+		OAuth2ClientSecret string
+		OAuth2Scopes       string // Comma separated
+		OAuth2Audience     string
 	}
 )
 
 var Default = &HTTP{
-	Timeout:   2000,
-	SSLVerify: true,
+	Timeout:      2000,
+	SSLVerify:    true,
+	Headers:      "",
+	Username:     "",
+	Password:     "",
+	Token:        "",
+	APIKey:       "",
+	APIKeyHeader: "X-API-Key",
+
+	CredentialsURLPrefixes: "",
+
+	Proxy:        "",
+	NoProxy:      "",
+	ClientCert:   "",
+	ClientKey:    "",
+	CACert:       "",
+	MaxRedirects: 10,
+	HTTP2:        false,
+
+	OAuth2TokenURL:     "",
+	OAuth2ClientID:     "",
+	OAuth2ClientSecret: "",
+	OAuth2Scopes:       "",
+	OAuth2Audience:     "",
+}
+
+// GetHeaders parse Headers
+func (c *HTTP) GetHeaders() (headers map[string]string, err error) {
+	if c.Headers != "" {
+		err = json.Unmarshal([]byte(c.Headers), &headers)
+	}
+	return
+}
+
+// GetCredentialsURLPrefixes parse CredentialsURLPrefixes, prefixes are required when credentials are set
+func (c *HTTP) GetCredentialsURLPrefixes() ([]string, error) {
+	var prefixes []string
+	for _, prefix := range strings.Split(c.CredentialsURLPrefixes, ",") {
+		if prefix = strings.TrimSpace(prefix); prefix == "" {
+			continue
+		}
+
+		if prefixURL, err := url.Parse(prefix); err != nil || prefixURL.Scheme != "http" && prefixURL.Scheme != "https" || prefixURL.Host == "" {
+			return nil, fmt.Errorf("expected http(s) URL prefix, got %q", prefix)
+		}
+		prefixes = append(prefixes, prefix)
+	}
+
+	if len(prefixes) == 0 && c.hasCredentials() {
+		return nil, errors.New("URL prefixes are required with credentials")
+	}
+
+	return prefixes, nil
+}
+
+// hasCredentials return true when basic auth, token, API key or OAuth2 is set
+func (c *HTTP) hasCredentials() bool {
+	return c.Username != "" || c.Password != "" || c.Token != "" || c.APIKey != "" ||
+		c.OAuth2TokenURL != "" || c.OAuth2ClientID != ""
+}
+
+// GetProxy parse Proxy, proxy is nil when Proxy is empty
+func (c *HTTP) GetProxy() (proxy func(*http.Request) (*url.URL, error), err error) {
+	if c.Proxy == "" {
+		return
+	}
+
+	proxyURL, err := url.Parse(c.Proxy)
+	if err != nil {
+		return
+	}
+	if proxyURL.Scheme != "http" && proxyURL.Scheme != "https" || proxyURL.Host == "" {
+		return nil, fmt.Errorf("expected http(s) URL")
+	}
+
+	proxyFunc := (&httpproxy.Config{HTTPProxy: c.Proxy, HTTPSProxy: c.Proxy, NoProxy: c.NoProxy}).ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) { return proxyFunc(req.URL) }, nil
+}
+
+// GetTLSConfig build tls.Config from SSLVerify, ClientCert, ClientKey and CACert
+func (c *HTTP) GetTLSConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: !c.SSLVerify}
+
+	if c.ClientCert != "" || c.ClientKey != "" {
+		if c.ClientCert == "" || c.ClientKey == "" {
+			return nil, errors.New("client certificate and key are both required for mutual TLS")
+		}
+
+		certificate, err := tls.LoadX509KeyPair(c.ClientCert, c.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	if c.CACert != "" {
+		pool, err := certpool.Load(c.CACert)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return tlsConfig, nil
+}
+
+// GetOAuth2Config build clientcredentials.Config, config is nil when OAuth2 isn't configured
+func (c *HTTP) GetOAuth2Config() (*clientcredentials.Config, error) {
+	if c.OAuth2TokenURL == "" && c.OAuth2ClientID == "" && c.OAuth2ClientSecret == "" {
+		return nil, nil
+	}
+
+	if c.OAuth2TokenURL == "" || c.OAuth2ClientID == "" {
+		return nil, errors.New("token URL and client ID are both required")
+	}
+	if tokenURL, err := url.Parse(c.OAuth2TokenURL); err != nil || tokenURL.Scheme != "http" && tokenURL.Scheme != "https" || tokenURL.Host == "" {
+		return nil, fmt.Errorf("expected http(s) token URL, got %q", c.OAuth2TokenURL)
+	}
+	if c.Token != "" {
+		return nil, errors.New("token and OAuth2 can't be used together")
+	}
+
+	oauth2Config := &clientcredentials.Config{
+		ClientID:     c.OAuth2ClientID,
+		ClientSecret: c.OAuth2ClientSecret,
+		TokenURL:     c.OAuth2TokenURL,
+	}
+	for _, scope := range strings.Split(c.OAuth2Scopes, ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			oauth2Config.Scopes = append(oauth2Config.Scopes, scope)
+		}
+	}
+	if c.OAuth2Audience != "" {
+		oauth2Config.EndpointParams = url.Values{"audience": []string{c.OAuth2Audience}}
+	}
+
+	return oauth2Config, nil
 }
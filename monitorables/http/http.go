@@ -3,6 +3,8 @@
 package http
 
 import (
+	"fmt"
+
 	"github.com/monitoror/monitoror/api/config/versions"
 	pkgMonitorable "github.com/monitoror/monitoror/internal/pkg/monitorable"
 	coreModels "github.com/monitoror/monitoror/models"
@@ -25,6 +27,7 @@ type Monitorable struct {
 	statusTileEnabler    registry.TileEnabler
 	rawTileEnabler       registry.TileEnabler
 	formattedTileEnabler registry.TileEnabler
+	latencyTileEnabler   registry.TileEnabler
 }
 
 func NewMonitorable(store *store.Store) *Monitorable {
@@ -39,6 +42,7 @@ func NewMonitorable(store *store.Store) *Monitorable {
 	m.statusTileEnabler = store.Registry.RegisterTile(api.HTTPStatusTileType, versions.MinimalVersion, m.GetVariantsNames())
 	m.rawTileEnabler = store.Registry.RegisterTile(api.HTTPRawTileType, versions.MinimalVersion, m.GetVariantsNames())
 	m.formattedTileEnabler = store.Registry.RegisterTile(api.HTTPFormattedTileType, versions.MinimalVersion, m.GetVariantsNames())
+	m.latencyTileEnabler = store.Registry.RegisterTile(api.HTTPLatencyTileType, versions.MinimalVersion, m.GetVariantsNames())
 
 	return m
 }
@@ -59,6 +63,26 @@ func (m *Monitorable) Validate(variantName coreModels.VariantName) (bool, []erro
 		return false, errors
 	}
 
+	if _, err := conf.GetHeaders(); err != nil {
+		return false, []error{fmt.Errorf("invalid headers %q, expected JSON object: %w", conf.Headers, err)}
+	}
+
+	if _, err := conf.GetCredentialsURLPrefixes(); err != nil {
+		return false, []error{fmt.Errorf("invalid credentials URL prefixes %q: %w", conf.CredentialsURLPrefixes, err)}
+	}
+
+	if _, err := conf.GetProxy(); err != nil {
+		return false, []error{fmt.Errorf("invalid proxy %q: %w", conf.Proxy, err)}
+	}
+
+	if _, err := conf.GetTLSConfig(); err != nil {
+		return false, []error{fmt.Errorf("invalid TLS configuration: %w", err)}
+	}
+
+	if _, err := conf.GetOAuth2Config(); err != nil {
+		return false, []error{fmt.Errorf("invalid OAuth2 configuration: %w", err)}
+	}
+
 	return true, nil
 }
 
@@ -74,9 +98,11 @@ func (m *Monitorable) Enable(variantName coreModels.VariantName) {
 	routeStatus := routeGroup.GET("/status", delivery.GetHTTPStatus)
 	routeRaw := routeGroup.GET("/raw", delivery.GetHTTPRaw)
 	routeJSON := routeGroup.GET("/formatted", delivery.GetHTTPFormatted)
+	routeLatency := routeGroup.GET("/latency", delivery.GetHTTPLatency)
 
 	// EnableTile data for config hydration
 	m.statusTileEnabler.Enable(variantName, &httpModels.HTTPStatusParams{}, routeStatus.Path)
 	m.rawTileEnabler.Enable(variantName, &httpModels.HTTPRawParams{}, routeRaw.Path)
 	m.formattedTileEnabler.Enable(variantName, &httpModels.HTTPFormattedParams{}, routeJSON.Path)
+	m.latencyTileEnabler.Enable(variantName, &httpModels.HTTPLatencyParams{}, routeLatency.Path)
 }
@@ -16,6 +16,30 @@ func TestNewMonitorable(t *testing.T) {
 	// init Env
 	// Wrong Timeout
 	_ = os.Setenv("MO_MONITORABLE_HTTP_VARIANT0_TIMEOUT", "-1000")
+	// Wrong Headers
+	_ = os.Setenv("MO_MONITORABLE_HTTP_VARIANT1_HEADERS", "X-Api-Version: 2")
+	// Headers and credentials
+	_ = os.Setenv("MO_MONITORABLE_HTTP_VARIANT2_HEADERS", `{"X-Api-Version": "2"}`)
+	_ = os.Setenv("MO_MONITORABLE_HTTP_VARIANT2_TOKEN", "token")
+	_ = os.Setenv("MO_MONITORABLE_HTTP_VARIANT2_CREDENTIALSURLPREFIXES", "https://api.example.com/v2, https://status.example.com")
+	// Wrong proxy
+	_ = os.Setenv("MO_MONITORABLE_HTTP_VARIANT3_PROXY", "proxy.example.com:3128")
+	// Missing CA bundle
+	_ = os.Setenv("MO_MONITORABLE_HTTP_VARIANT4_CACERT", "/missing/ca.pem")
+	// Client certificate without key
+	_ = os.Setenv("MO_MONITORABLE_HTTP_VARIANT5_CLIENTCERT", "/missing/client.pem")
+	// OAuth2 without token URL
+	_ = os.Setenv("MO_MONITORABLE_HTTP_VARIANT6_OAUTH2CLIENTID", "monitoror")
+	// OAuth2
+	_ = os.Setenv("MO_MONITORABLE_HTTP_VARIANT7_OAUTH2TOKENURL", "https://auth.example.com/token")
+	_ = os.Setenv("MO_MONITORABLE_HTTP_VARIANT7_OAUTH2CLIENTID", "monitoror")
+	_ = os.Setenv("MO_MONITORABLE_HTTP_VARIANT7_OAUTH2CLIENTSECRET", "secret")
+	_ = os.Setenv("MO_MONITORABLE_HTTP_VARIANT7_CREDENTIALSURLPREFIXES", "https://api.example.com")
+	// Credentials without URL prefixes
+	_ = os.Setenv("MO_MONITORABLE_HTTP_VARIANT8_APIKEY", "key")
+	// Wrong credentials URL prefixes
+	_ = os.Setenv("MO_MONITORABLE_HTTP_VARIANT9_APIKEY", "key")
+	_ = os.Setenv("MO_MONITORABLE_HTTP_VARIANT9_CREDENTIALSURLPREFIXES", "api.example.com")
 
 	// NewMonitorable
 	monitorable := NewMonitorable(store)
@@ -25,9 +49,29 @@ func TestNewMonitorable(t *testing.T) {
 	assert.NotNil(t, monitorable.GetDisplayName())
 
 	// GetVariantsNames and check
-	if assert.Len(t, monitorable.GetVariantsNames(), 2) {
+	if assert.Len(t, monitorable.GetVariantsNames(), 11) {
 		_, errors := monitorable.Validate("variant0")
 		assert.NotEmpty(t, errors)
+		_, errors = monitorable.Validate("variant1")
+		assert.NotEmpty(t, errors)
+		valid, errors := monitorable.Validate("variant2")
+		assert.True(t, valid)
+		assert.Empty(t, errors)
+		_, errors = monitorable.Validate("variant3")
+		assert.NotEmpty(t, errors)
+		_, errors = monitorable.Validate("variant4")
+		assert.NotEmpty(t, errors)
+		_, errors = monitorable.Validate("variant5")
+		assert.NotEmpty(t, errors)
+		_, errors = monitorable.Validate("variant6")
+		assert.NotEmpty(t, errors)
+		valid, errors = monitorable.Validate("variant7")
+		assert.True(t, valid)
+		assert.Empty(t, errors)
+		_, errors = monitorable.Validate("variant8")
+		assert.NotEmpty(t, errors)
+		_, errors = monitorable.Validate("variant9")
+		assert.NotEmpty(t, errors)
 	}
 
 	// Enable
@@ -38,6 +82,6 @@ func TestNewMonitorable(t *testing.T) {
 	}
 
 	// Test calls
-	mockMonitorableHelper.RouterAssertNumberOfCalls(t, 1, 3)
-	mockMonitorableHelper.TileSettingsManagerAssertNumberOfCalls(t, 3, 0, 3, 0)
+	mockMonitorableHelper.RouterAssertNumberOfCalls(t, 3, 12)
+	mockMonitorableHelper.TileSettingsManagerAssertNumberOfCalls(t, 4, 0, 12, 0)
 }
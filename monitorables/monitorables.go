@@ -2,24 +2,34 @@ package monitorables
 
 import (
 	"github.com/monitoror/monitoror/monitorables/azuredevops"
+	"github.com/monitoror/monitoror/monitorables/dns"
 	"github.com/monitoror/monitoror/monitorables/github"
 	"github.com/monitoror/monitoror/monitorables/gitlab"
+	"github.com/monitoror/monitoror/monitorables/heartbeat"
 	"github.com/monitoror/monitoror/monitorables/http"
 	"github.com/monitoror/monitoror/monitorables/jenkins"
 	"github.com/monitoror/monitoror/monitorables/ping"
 	"github.com/monitoror/monitoror/monitorables/pingdom"
+	"github.com/monitoror/monitoror/monitorables/plugin"
 	"github.com/monitoror/monitoror/monitorables/port"
+	"github.com/monitoror/monitoror/monitorables/script"
+	"github.com/monitoror/monitoror/monitorables/tlscertificate"
 	"github.com/monitoror/monitoror/monitorables/travisci"
+	"github.com/monitoror/monitoror/monitorables/webhook"
 	"github.com/monitoror/monitoror/store"
 )
 
 func RegisterMonitorables(s *store.Store) {
 	// ------------ AZURE DEVOPS ------------
 	s.Registry.RegisterMonitorable(azuredevops.NewMonitorable(s))
+	// ------------ DNS ------------
+	s.Registry.RegisterMonitorable(dns.NewMonitorable(s))
 	// ------------ GITHUB ------------
 	s.Registry.RegisterMonitorable(github.NewMonitorable(s))
 	// ------------ GITLAB ------------
 	s.Registry.RegisterMonitorable(gitlab.NewMonitorable(s))
+	// ------------ HEARTBEAT ------------
+	s.Registry.RegisterMonitorable(heartbeat.NewMonitorable(s))
 	// ------------ HTTP ------------
 	s.Registry.RegisterMonitorable(http.NewMonitorable(s))
 	// ------------ JENKINS ------------
@@ -30,6 +40,16 @@ func RegisterMonitorables(s *store.Store) {
 	s.Registry.RegisterMonitorable(pingdom.NewMonitorable(s))
 	// ------------ PORT ------------
 	s.Registry.RegisterMonitorable(port.NewMonitorable(s))
+	// ------------ SCRIPT ------------
+	s.Registry.RegisterMonitorable(script.NewMonitorable(s))
+	// ------------ TLS CERTIFICATE ------------
+	s.Registry.RegisterMonitorable(tlscertificate.NewMonitorable(s))
 	// ------------ TRAVIS CI ------------
 	s.Registry.RegisterMonitorable(travisci.NewMonitorable(s))
+	// ------------ WEBHOOK ------------
+	s.Registry.RegisterMonitorable(webhook.NewMonitorable(s))
+
+	// ------------ PLUGIN ------------
+	// Registered last, tile types of plugins are checked against every other registered tile type
+	s.Registry.RegisterMonitorable(plugin.NewMonitorable(s))
 }
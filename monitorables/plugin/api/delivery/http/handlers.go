@@ -0,0 +1,38 @@
+package http
+
+import (
+	"net/http"
+
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/plugin/api"
+	"github.com/monitoror/monitoror/monitorables/plugin/api/models"
+
+	"github.com/labstack/echo/v4"
+)
+
+type PluginDelivery struct {
+	pluginUsecase api.Usecase
+}
+
+func NewPluginDelivery(p api.Usecase) *PluginDelivery {
+	return &PluginDelivery{p}
+}
+
+// GetTile return handler proxying tile advertised in manifest to plugin
+func (h *PluginDelivery) GetTile(tileManifest models.TileManifest) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		// Bind / check Params
+		params := models.NewPluginParams(tileManifest.Params)
+		params.BindQuery(c.QueryParams())
+		if errors := params.Validate(); len(errors) > 0 {
+			return &coreModels.MonitororError{Message: errors[0].Error()}
+		}
+
+		tile, err := h.pluginUsecase.Tile(tileManifest.Type, params)
+		if err != nil {
+			return err
+		}
+
+		return c.JSON(http.StatusOK, tile)
+	}
+}
@@ -0,0 +1,84 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/plugin/api/mocks"
+	"github.com/monitoror/monitoror/monitorables/plugin/api/models"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	. "github.com/stretchr/testify/mock"
+)
+
+var tileManifest = models.TileManifest{
+	Type:   "ACME-QUEUE",
+	Params: []models.ParamManifest{{Name: "queue", Type: models.StringParamType, Required: true}},
+}
+
+func initEcho(query string) (ctx echo.Context, res *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(echo.GET, "/api/v1/plugin/acme-queue"+query, nil)
+	res = httptest.NewRecorder()
+	ctx = e.NewContext(req, res)
+
+	return
+}
+
+func TestDelivery_GetTile_Success(t *testing.T) {
+	// Init
+	ctx, res := initEcho("?queue=orders")
+
+	tile := coreModels.NewTile(tileManifest.Type)
+	tile.Status = coreModels.SuccessStatus
+
+	mockUsecase := new(mocks.Usecase)
+	mockUsecase.On("Tile", tileManifest.Type, AnythingOfType("*models.PluginParams")).Return(tile, nil)
+	handler := NewPluginDelivery(mockUsecase)
+
+	// Expected
+	json, err := json.Marshal(tile)
+	assert.NoError(t, err, "unable to marshal tile")
+
+	// Test
+	if assert.NoError(t, handler.GetTile(tileManifest)(ctx)) {
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(json), strings.TrimSpace(res.Body.String()))
+		mockUsecase.AssertNumberOfCalls(t, "Tile", 1)
+		mockUsecase.AssertExpectations(t)
+	}
+}
+
+func TestDelivery_GetTile_QueryParamsError(t *testing.T) {
+	// Init
+	ctx, _ := initEcho("")
+
+	mockUsecase := new(mocks.Usecase)
+	handler := NewPluginDelivery(mockUsecase)
+
+	// Test
+	err := handler.GetTile(tileManifest)(ctx)
+	assert.Error(t, err)
+	assert.IsType(t, &coreModels.MonitororError{}, err)
+	mockUsecase.AssertNumberOfCalls(t, "Tile", 0)
+}
+
+func TestDelivery_GetTile_Error(t *testing.T) {
+	// Init
+	ctx, _ := initEcho("?queue=orders")
+
+	mockUsecase := new(mocks.Usecase)
+	mockUsecase.On("Tile", Anything, Anything).Return(nil, errors.New("plugin error"))
+	handler := NewPluginDelivery(mockUsecase)
+
+	// Test
+	assert.Error(t, handler.GetTile(tileManifest)(ctx))
+	mockUsecase.AssertNumberOfCalls(t, "Tile", 1)
+	mockUsecase.AssertExpectations(t)
+}
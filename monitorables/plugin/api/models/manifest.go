@@ -0,0 +1,40 @@
+package models
+
+import (
+	coreModels "github.com/monitoror/monitoror/models"
+)
+
+type (
+	// Manifest is advertised by plugin service on ManifestPath. It lists tiles served by plugin
+	Manifest struct {
+		Name  string         `json:"name"`
+		Tiles []TileManifest `json:"tiles"`
+	}
+
+	TileManifest struct {
+		Type   coreModels.TileType `json:"type"`
+		Params []ParamManifest     `json:"params"`
+	}
+
+	ParamManifest struct {
+		Name     string    `json:"name"`
+		Type     ParamType `json:"type"`
+		Required bool      `json:"required"`
+	}
+
+	ParamType string
+)
+
+const (
+	// ManifestPath is the path of the manifest on plugin service
+	ManifestPath = "/manifest"
+	// TilesPath is the path prefix of tiles on plugin service, followed by tile type
+	TilesPath = "/tiles"
+)
+
+const (
+	StringParamType  ParamType = "string"
+	NumberParamType  ParamType = "number"
+	BooleanParamType ParamType = "boolean"
+	ArrayParamType   ParamType = "array" // Array of string
+)
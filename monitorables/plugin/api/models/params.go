@@ -0,0 +1,137 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/params"
+	"github.com/monitoror/monitoror/internal/pkg/validator"
+	"github.com/monitoror/monitoror/pkg/humanize"
+)
+
+type (
+	// PluginParams hold params of a plugin tile. They are validated against schema advertised in plugin manifest
+	PluginParams struct {
+		schema []ParamManifest
+		values map[string]interface{}
+	}
+)
+
+func NewPluginParams(schema []ParamManifest) *PluginParams {
+	return &PluginParams{schema: schema, values: make(map[string]interface{})}
+}
+
+// NewValidator implements params.Factory, params need schema to be validated
+func (p *PluginParams) NewValidator() params.Validator {
+	return NewPluginParams(p.schema)
+}
+
+// UnmarshalJSON keep only params declared in schema, others are reported as unknown by config verify
+func (p *PluginParams) UnmarshalJSON(bytes []byte) error {
+	values := make(map[string]interface{})
+	if err := json.Unmarshal(bytes, &values); err != nil {
+		return err
+	}
+
+	p.values = make(map[string]interface{})
+	for _, param := range p.schema {
+		if value, ok := values[param.Name]; ok {
+			p.values[param.Name] = value
+		}
+	}
+
+	return nil
+}
+
+func (p *PluginParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.values)
+}
+
+// BindQuery fill params from query params. Only params declared in schema are used
+func (p *PluginParams) BindQuery(query url.Values) {
+	p.values = make(map[string]interface{})
+	for _, param := range p.schema {
+		values, ok := query[param.Name]
+		if !ok {
+			continue
+		}
+
+		if param.Type == ArrayParamType {
+			p.values[param.Name] = values
+		} else {
+			p.values[param.Name] = values[0]
+		}
+	}
+}
+
+// Query return params as query params, used to call plugin service
+func (p *PluginParams) Query() url.Values {
+	query := url.Values{}
+	for key, value := range p.values {
+		switch v := value.(type) {
+		case []string:
+			query[key] = v
+		case []interface{}:
+			for _, item := range v {
+				query.Add(key, humanize.Interface(item))
+			}
+		default:
+			query.Set(key, humanize.Interface(v))
+		}
+	}
+	return query
+}
+
+func (p *PluginParams) Validate() []validator.Error {
+	var errors []validator.Error
+
+	for _, param := range p.schema {
+		value, ok := p.values[param.Name]
+		if !ok {
+			if param.Required {
+				errors = append(errors, validator.NewDefaultError(param.Name, "set"))
+			}
+			continue
+		}
+
+		if !checkParamType(param.Type, value) {
+			errors = append(errors, validator.NewDefaultError(param.Name, fmt.Sprintf("a %s", param.Type)))
+		}
+	}
+
+	return errors
+}
+
+// checkParamType check value type from config (json types) or from query (strings)
+func checkParamType(paramType ParamType, value interface{}) bool {
+	switch paramType {
+	case StringParamType:
+		_, ok := value.(string)
+		return ok
+	case NumberParamType:
+		switch v := value.(type) {
+		case float64:
+			return true
+		case string:
+			_, err := strconv.ParseFloat(v, 64)
+			return err == nil
+		}
+	case BooleanParamType:
+		switch v := value.(type) {
+		case bool:
+			return true
+		case string:
+			_, err := strconv.ParseBool(v)
+			return err == nil
+		}
+	case ArrayParamType:
+		switch value.(type) {
+		case []string, []interface{}:
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/params"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var schema = []ParamManifest{
+	{Name: "queue", Type: StringParamType, Required: true},
+	{Name: "max", Type: NumberParamType},
+	{Name: "strict", Type: BooleanParamType},
+	{Name: "tags", Type: ArrayParamType},
+}
+
+func TestPluginParams_Validate(t *testing.T) {
+	for _, testcase := range []struct {
+		json       string
+		errorCount int
+	}{
+		{`{}`, 1},
+		{`{"queue": "orders"}`, 0},
+		{`{"queue": "orders", "max": 10, "strict": true, "tags": ["a", "b"]}`, 0},
+		{`{"queue": 10, "max": "ten", "strict": "yes", "tags": "a"}`, 4},
+	} {
+		p := NewPluginParams(schema).NewValidator()
+		if assert.NoError(t, json.Unmarshal([]byte(testcase.json), p)) {
+			assert.Len(t, p.Validate(), testcase.errorCount, testcase.json)
+		}
+	}
+}
+
+func TestPluginParams_Query(t *testing.T) {
+	p := NewPluginParams(schema)
+	p.BindQuery(url.Values{"queue": {"orders"}, "max": {"10"}, "tags": {"a", "b"}, "unknown": {"value"}})
+	assert.Empty(t, p.Validate())
+	assert.Equal(t, url.Values{"queue": {"orders"}, "max": {"10"}, "tags": {"a", "b"}}, p.Query())
+
+	p = NewPluginParams(schema)
+	assert.NoError(t, json.Unmarshal([]byte(`{"queue": "orders", "max": 10, "strict": true, "tags": ["a"], "unknown": 1}`), p))
+	assert.Equal(t, url.Values{"queue": {"orders"}, "max": {"10"}, "strict": {"true"}, "tags": {"a"}}, p.Query())
+
+	bytes, err := json.Marshal(p)
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{"queue": "orders", "max": 10, "strict": true, "tags": ["a"]}`, string(bytes))
+	}
+}
+
+func TestPluginParams_Factory(t *testing.T) {
+	var p interface{} = NewPluginParams(schema)
+	_, ok := p.(params.Factory)
+	assert.True(t, ok)
+}
@@ -0,0 +1,17 @@
+//go:generate mockery -name Repository
+
+package api
+
+import (
+	"net/url"
+
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/plugin/api/models"
+)
+
+type (
+	Repository interface {
+		GetManifest() (*models.Manifest, error)
+		GetTile(tileType coreModels.TileType, query url.Values) (*coreModels.Tile, error)
+	}
+)
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/plugin/api"
+	"github.com/monitoror/monitoror/monitorables/plugin/api/models"
+	"github.com/monitoror/monitoror/monitorables/plugin/config"
+)
+
+type (
+	pluginRepository struct {
+		httpClient *http.Client
+		config     *config.Plugin
+	}
+)
+
+// maxResponseSize limit size of plugin response
+const maxResponseSize = 1024 * 1024
+
+func NewPluginRepository(config *config.Plugin) api.Repository {
+	tr := &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: !config.SSLVerify},
+	}
+	client := &http.Client{Transport: tr, Timeout: time.Duration(config.Timeout) * time.Millisecond}
+
+	return &pluginRepository{client, config}
+}
+
+func (r *pluginRepository) GetManifest() (*models.Manifest, error) {
+	manifest := &models.Manifest{}
+	if err := r.get(models.ManifestPath, nil, manifest); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+func (r *pluginRepository) GetTile(tileType coreModels.TileType, query url.Values) (*coreModels.Tile, error) {
+	tile := &coreModels.Tile{}
+	if err := r.get(fmt.Sprintf("%s/%s", models.TilesPath, url.PathEscape(string(tileType))), query, tile); err != nil {
+		return nil, err
+	}
+
+	return tile, nil
+}
+
+func (r *pluginRepository) get(path string, query url.Values, result interface{}) error {
+	requestURL := strings.TrimSuffix(r.config.URL, "/") + path
+	if len(query) > 0 {
+		requestURL = fmt.Sprintf("%s?%s", requestURL, query.Encode())
+	}
+
+	req, err := http.NewRequest(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if r.config.Token != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", r.config.Token))
+	}
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("plugin responded with status code %d", resp.StatusCode)
+	}
+
+	return json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(result)
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/plugin/config"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func initServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+
+		switch r.URL.Path {
+		case "/plugin/manifest":
+			_, _ = w.Write([]byte(`{"name": "acme", "tiles": [{"type": "ACME-QUEUE", "params": [{"name": "queue", "type": "string", "required": true}]}]}`))
+		case "/plugin/tiles/ACME-QUEUE":
+			assert.Equal(t, "orders", r.URL.Query().Get("queue"))
+			_, _ = w.Write([]byte(`{"type": "ACME-QUEUE", "status": "SUCCESS", "label": "orders"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestPluginRepository_GetManifest(t *testing.T) {
+	server := initServer(t)
+	defer server.Close()
+
+	repository := NewPluginRepository(&config.Plugin{URL: server.URL + "/plugin/", Token: "token", Timeout: 1000})
+
+	manifest, err := repository.GetManifest()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "acme", manifest.Name)
+		if assert.Len(t, manifest.Tiles, 1) {
+			assert.Equal(t, coreModels.TileType("ACME-QUEUE"), manifest.Tiles[0].Type)
+			assert.Len(t, manifest.Tiles[0].Params, 1)
+		}
+	}
+}
+
+func TestPluginRepository_GetTile(t *testing.T) {
+	server := initServer(t)
+	defer server.Close()
+
+	repository := NewPluginRepository(&config.Plugin{URL: server.URL + "/plugin", Token: "token", Timeout: 1000})
+
+	tile, err := repository.GetTile("ACME-QUEUE", url.Values{"queue": []string{"orders"}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, coreModels.SuccessStatus, tile.Status)
+		assert.Equal(t, "orders", tile.Label)
+	}
+
+	_, err = repository.GetTile("ACME-UNKNOWN", nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,14 @@
+//go:generate mockery -name Usecase
+
+package api
+
+import (
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/plugin/api/models"
+)
+
+type (
+	Usecase interface {
+		Tile(tileType coreModels.TileType, params *models.PluginParams) (*coreModels.Tile, error)
+	}
+)
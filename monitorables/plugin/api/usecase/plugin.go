@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/plugin/api"
+	"github.com/monitoror/monitoror/monitorables/plugin/api/models"
+)
+
+type (
+	pluginUsecase struct {
+		repository api.Repository
+	}
+)
+
+func NewPluginUsecase(repository api.Repository) api.Usecase {
+	return &pluginUsecase{repository}
+}
+
+func (pu *pluginUsecase) Tile(tileType coreModels.TileType, params *models.PluginParams) (*coreModels.Tile, error) {
+	tile, err := pu.repository.GetTile(tileType, params.Query())
+	if err != nil {
+		return nil, &coreModels.MonitororError{Err: err, Tile: coreModels.NewTile(tileType), Message: "unable to get tile from plugin"}
+	}
+
+	// Plugin can't change tile type
+	tile.Type = tileType
+	if tile.Status == "" {
+		tile.Status = coreModels.UnknownStatus
+	}
+
+	return tile, nil
+}
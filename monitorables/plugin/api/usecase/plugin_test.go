@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/plugin/api/mocks"
+	"github.com/monitoror/monitoror/monitorables/plugin/api/models"
+
+	"github.com/stretchr/testify/assert"
+	. "github.com/stretchr/testify/mock"
+)
+
+var schema = []models.ParamManifest{{Name: "queue", Type: models.StringParamType, Required: true}}
+
+func TestUsecase_Tile(t *testing.T) {
+	mockRepository := new(mocks.Repository)
+	mockRepository.On("GetTile", coreModels.TileType("ACME-QUEUE"), url.Values{"queue": []string{"orders"}}).
+		Return(&coreModels.Tile{Type: "OTHER", Label: "orders"}, nil)
+	usecase := NewPluginUsecase(mockRepository)
+
+	params := models.NewPluginParams(schema)
+	params.BindQuery(url.Values{"queue": []string{"orders"}, "cacheExpiration": []string{"1000"}})
+
+	tile, err := usecase.Tile("ACME-QUEUE", params)
+	if assert.NoError(t, err) {
+		assert.Equal(t, &coreModels.Tile{Type: "ACME-QUEUE", Status: coreModels.UnknownStatus, Label: "orders"}, tile)
+		mockRepository.AssertExpectations(t)
+	}
+}
+
+func TestUsecase_Tile_Error(t *testing.T) {
+	mockRepository := new(mocks.Repository)
+	mockRepository.On("GetTile", Anything, Anything).Return(nil, errors.New("boom"))
+	usecase := NewPluginUsecase(mockRepository)
+
+	tile, err := usecase.Tile("ACME-QUEUE", models.NewPluginParams(schema))
+	if assert.Error(t, err) {
+		assert.Nil(t, tile)
+		assert.IsType(t, &coreModels.MonitororError{}, err)
+		assert.Equal(t, coreModels.TileType("ACME-QUEUE"), err.(*coreModels.MonitororError).Tile.Type)
+	}
+}
@@ -0,0 +1,19 @@
+package config
+
+type (
+	Plugin struct {
+		URL                   string `validate:"required,url,http"` // Base URL of the plugin service
+		Token                 string // Sent as Bearer token to the plugin service
+		Timeout               int    `validate:"gte=0"` // In Millisecond
+		SSLVerify             bool
+		ManifestRetryInterval int `validate:"gte=0"` // In Millisecond, 0 disable retry of unavailable manifest
+	}
+)
+
+var Default = &Plugin{
+	URL:                   "",
+	Token:                 "",
+	Timeout:               2000,
+	SSLVerify:             true,
+	ManifestRetryInterval: 30000,
+}
@@ -0,0 +1,242 @@
+package plugin
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	configUsecase "github.com/monitoror/monitoror/api/config/usecase"
+	"github.com/monitoror/monitoror/api/config/versions"
+	pkgMonitorable "github.com/monitoror/monitoror/internal/pkg/monitorable"
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/plugin/api"
+	pluginDelivery "github.com/monitoror/monitoror/monitorables/plugin/api/delivery/http"
+	pluginModels "github.com/monitoror/monitoror/monitorables/plugin/api/models"
+	pluginRepository "github.com/monitoror/monitoror/monitorables/plugin/api/repository"
+	pluginUsecase "github.com/monitoror/monitoror/monitorables/plugin/api/usecase"
+	pluginConfig "github.com/monitoror/monitoror/monitorables/plugin/config"
+	"github.com/monitoror/monitoror/registry"
+	"github.com/monitoror/monitoror/store"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+// Monitorable has no faker version, fake data are provided by plugin services themselves
+type Monitorable struct {
+	store *store.Store
+
+	config map[coreModels.VariantName]*pluginConfig.Plugin
+
+	// Manifests advertised by plugin services, loaded on startup or retried in background until they are available
+	mutex          sync.RWMutex
+	manifests      map[coreModels.VariantName]*pluginModels.Manifest
+	manifestErrors map[coreModels.VariantName]error
+
+	// Config tile settings, by tile type advertised in manifests
+	tileEnablers map[coreModels.TileType]registry.TileEnabler
+}
+
+// tileTypeParam is the route param used to dispatch tile requests, routes can't be added once server is started
+const tileTypeParam = "tileType"
+
+var tileTypeRegex = regexp.MustCompile(`^[A-Z0-9]+(-[A-Z0-9]+)*$`)
+
+// reservedTileTypes handled by the core itself, without registering them
+var reservedTileTypes = map[coreModels.TileType]bool{
+	configUsecase.EmptyTileType:   true,
+	configUsecase.GroupTileType:   true,
+	configUsecase.SummaryTileType: true,
+}
+
+func NewMonitorable(store *store.Store) *Monitorable {
+	m := &Monitorable{}
+	m.store = store
+	m.config = make(map[coreModels.VariantName]*pluginConfig.Plugin)
+	m.manifests = make(map[coreModels.VariantName]*pluginModels.Manifest)
+	m.manifestErrors = make(map[coreModels.VariantName]error)
+	m.tileEnablers = make(map[coreModels.TileType]registry.TileEnabler)
+
+	// Load core config from env
+	pkgMonitorable.LoadConfig(&m.config, pluginConfig.Default)
+
+	// Load manifests of configured plugins
+	for variantName, conf := range m.config {
+		if conf.URL == "" {
+			continue
+		}
+
+		if manifest, err := m.loadManifest(pluginRepository.NewPluginRepository(conf)); err != nil {
+			m.manifestErrors[variantName] = err
+		} else {
+			m.manifests[variantName] = manifest
+		}
+	}
+
+	return m
+}
+
+func (m *Monitorable) GetDisplayName() string {
+	return "Plugin"
+}
+
+func (m *Monitorable) GetVariantsNames() []coreModels.VariantName {
+	return pkgMonitorable.GetVariantsNames(m.config)
+}
+
+func (m *Monitorable) Validate(variantName coreModels.VariantName) (bool, []error) {
+	conf := m.config[variantName]
+
+	// No configuration set
+	if conf.URL == "" {
+		return false, nil
+	}
+
+	// Validate Config
+	if errors := pkgMonitorable.ValidateConfig(conf, variantName); errors != nil {
+		return false, errors
+	}
+
+	if err, ok := m.manifestErrors[variantName]; ok {
+		err = fmt.Errorf("unable to load plugin manifest from %s: %w", conf.URL, err)
+		if conf.ManifestRetryInterval == 0 {
+			return false, []error{err}
+		}
+
+		// Variant stay enabled, tiles are enabled when manifest is loaded
+		return true, []error{fmt.Errorf("%w, retrying every %s", err, time.Duration(conf.ManifestRetryInterval)*time.Millisecond)}
+	}
+
+	return true, nil
+}
+
+func (m *Monitorable) Enable(variantName coreModels.VariantName) {
+	conf := m.config[variantName]
+
+	repository := pluginRepository.NewPluginRepository(conf)
+	usecase := pluginUsecase.NewPluginUsecase(repository)
+	delivery := pluginDelivery.NewPluginDelivery(usecase)
+
+	// EnableTile route to echo
+	routeGroup := m.store.MonitorableRouter.Group("/plugin", variantName)
+	route := routeGroup.GET(fmt.Sprintf("/:%s", tileTypeParam), m.getTile(variantName, delivery))
+
+	m.mutex.RLock()
+	manifest, loaded := m.manifests[variantName]
+	m.mutex.RUnlock()
+
+	if loaded {
+		m.enableTiles(variantName, manifest, route.Path)
+	} else {
+		go m.retryManifest(variantName, repository, route.Path)
+	}
+}
+
+// getTile dispatch request to tile advertised in manifest of variant
+func (m *Monitorable) getTile(variantName coreModels.VariantName, delivery *pluginDelivery.PluginDelivery) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		tileType := coreModels.TileType(strings.ToUpper(c.Param(tileTypeParam)))
+
+		m.mutex.RLock()
+		manifest := m.manifests[variantName]
+		m.mutex.RUnlock()
+
+		if manifest != nil {
+			for _, tile := range manifest.Tiles {
+				if tile.Type == tileType {
+					return delivery.GetTile(tile)(c)
+				}
+			}
+		}
+
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("unknown %s tile", tileType))
+	}
+}
+
+// retryManifest load manifest of unavailable plugin in background, then enable its tiles
+func (m *Monitorable) retryManifest(variantName coreModels.VariantName, repository api.Repository, routePath string) {
+	ticker := time.NewTicker(time.Duration(m.config[variantName].ManifestRetryInterval) * time.Millisecond)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		manifest, err := m.loadManifest(repository)
+		if err != nil {
+			log.Warnf("unable to load plugin manifest from %s: %v", m.config[variantName].URL, err)
+			continue
+		}
+
+		m.mutex.Lock()
+		m.manifests[variantName] = manifest
+		m.mutex.Unlock()
+
+		m.enableTiles(variantName, manifest, routePath)
+		return
+	}
+}
+
+// loadManifest fetch and validate plugin manifest
+func (m *Monitorable) loadManifest(repository api.Repository) (*pluginModels.Manifest, error) {
+	manifest, err := repository.GetManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	if err := validateManifest(manifest, m.store.Registry, m.tileEnablers); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// enableTiles register and enable tiles of manifest for config verify / hydrate
+func (m *Monitorable) enableTiles(variantName coreModels.VariantName, manifest *pluginModels.Manifest, routePath string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, tile := range manifest.Tiles {
+		// Register Monitorable Tile in config manager, variants of tile types advertised by several plugins are merged
+		m.tileEnablers[tile.Type] = m.store.Registry.RegisterTile(tile.Type, versions.MinimalVersion, []coreModels.VariantName{variantName})
+
+		// EnableTile data for config hydration
+		tilePath := strings.Replace(routePath, ":"+tileTypeParam, strings.ToLower(string(tile.Type)), 1)
+		m.tileEnablers[tile.Type].Enable(variantName, pluginModels.NewPluginParams(tile.Params), tilePath)
+	}
+}
+
+// validateManifest check tiles types and params schema advertised by plugin.
+// Tile types of the core and of other monitorables can't be overridden by plugin, pluginTileTypes can be shared between plugins
+func validateManifest(manifest *pluginModels.Manifest, registry registry.Registry, pluginTileTypes map[coreModels.TileType]registry.TileEnabler) error {
+	tileTypes := make(map[coreModels.TileType]bool)
+	for _, tile := range manifest.Tiles {
+		if !tileTypeRegex.MatchString(string(tile.Type)) {
+			return fmt.Errorf("invalid tile type %q, must match %s", tile.Type, tileTypeRegex.String())
+		}
+		if _, ok := pluginTileTypes[tile.Type]; !ok && (reservedTileTypes[tile.Type] || registry.IsTileRegistered(tile.Type)) {
+			return fmt.Errorf("tile type %q is already used by monitoror, plugin can't override it", tile.Type)
+		}
+		if tileTypes[tile.Type] {
+			return fmt.Errorf("duplicate tile type %q", tile.Type)
+		}
+		tileTypes[tile.Type] = true
+
+		for _, param := range tile.Params {
+			if param.Name == "" {
+				return fmt.Errorf("missing param name in %s tile", tile.Type)
+			}
+
+			switch param.Type {
+			case pluginModels.StringParamType, pluginModels.NumberParamType, pluginModels.BooleanParamType, pluginModels.ArrayParamType:
+			default:
+				return fmt.Errorf("invalid type %q of %s param in %s tile", param.Type, param.Name, tile.Type)
+			}
+		}
+	}
+
+	return nil
+}
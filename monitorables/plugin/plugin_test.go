@@ -0,0 +1,175 @@
+package plugin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/monitoror/monitoror/api/config/versions"
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/test"
+	coreModels "github.com/monitoror/monitoror/models"
+	pluginModels "github.com/monitoror/monitoror/monitorables/plugin/api/models"
+	"github.com/monitoror/monitoror/registry"
+	serviceMocks "github.com/monitoror/monitoror/service/mocks"
+	"github.com/monitoror/monitoror/store"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	. "github.com/stretchr/testify/mock"
+)
+
+func TestNewMonitorable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/valid/manifest":
+			_, _ = w.Write([]byte(`{"name": "acme", "tiles": [{"type": "ACME-QUEUE", "params": [{"name": "queue", "type": "string"}]}, {"type": "ACME-JOB"}]}`))
+		case "/invalid/manifest":
+			_, _ = w.Write([]byte(`{"name": "acme", "tiles": [{"type": "acme queue"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	// init Store
+	store, mockMonitorableHelper := test.InitMockAndStore()
+
+	// init Env
+	_ = os.Setenv("MO_MONITORABLE_PLUGIN_URL", server.URL+"/valid")
+	// Invalid manifest
+	_ = os.Setenv("MO_MONITORABLE_PLUGIN_VARIANT0_URL", server.URL+"/invalid")
+	_ = os.Setenv("MO_MONITORABLE_PLUGIN_VARIANT0_MANIFESTRETRYINTERVAL", "0")
+	// Missing manifest
+	_ = os.Setenv("MO_MONITORABLE_PLUGIN_VARIANT1_URL", server.URL+"/missing")
+	_ = os.Setenv("MO_MONITORABLE_PLUGIN_VARIANT1_MANIFESTRETRYINTERVAL", "0")
+	// Wrong Timeout
+	_ = os.Setenv("MO_MONITORABLE_PLUGIN_VARIANT2_URL", server.URL+"/valid")
+	_ = os.Setenv("MO_MONITORABLE_PLUGIN_VARIANT2_TIMEOUT", "-1000")
+
+	// NewMonitorable
+	monitorable := NewMonitorable(store)
+	assert.NotNil(t, monitorable)
+
+	// GetDisplayName
+	assert.NotNil(t, monitorable.GetDisplayName())
+
+	// GetVariantsNames and check
+	if assert.Len(t, monitorable.GetVariantsNames(), 4) {
+		for _, variantName := range []string{"variant0", "variant1", "variant2"} {
+			valid, errors := monitorable.Validate(coreModels.VariantName(variantName))
+			assert.False(t, valid, variantName)
+			assert.NotEmpty(t, errors, variantName)
+		}
+	}
+
+	// Enable
+	for _, variantName := range monitorable.GetVariantsNames() {
+		if valid, _ := monitorable.Validate(variantName); valid {
+			monitorable.Enable(variantName)
+		}
+	}
+
+	// Test calls
+	mockMonitorableHelper.RouterAssertNumberOfCalls(t, 1, 1)
+	mockMonitorableHelper.TileSettingsManagerAssertNumberOfCalls(t, 2, 0, 2, 0)
+}
+
+func TestMonitorable_RetryManifest(t *testing.T) {
+	// Plugin is down at boot, then up
+	var manifestCalls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/retry/manifest":
+			if atomic.AddInt32(&manifestCalls, 1) <= 2 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write([]byte(`{"name": "acme", "tiles": [{"type": "ACME-RETRY"}]}`))
+		case "/retry/tiles/ACME-RETRY":
+			_, _ = w.Write([]byte(`{"status": "SUCCESS"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	// init Store
+	mockRouterGroup := new(serviceMocks.MonitorableRouterGroup)
+	mockRouterGroup.On("GET", "/:tileType", AnythingOfType("echo.HandlerFunc")).Return(&echo.Route{Path: "/api/v1/plugin/retry/:tileType"})
+	mockRouter := new(serviceMocks.MonitorableRouter)
+	mockRouter.On("Group", "/plugin", coreModels.VariantName("retry")).Return(mockRouterGroup)
+	metadataRegistry := registry.NewRegistry()
+	s := &store.Store{MonitorableRouter: mockRouter, Registry: metadataRegistry}
+
+	// init Env
+	_ = os.Setenv("MO_MONITORABLE_PLUGIN_RETRY_URL", server.URL+"/retry")
+	_ = os.Setenv("MO_MONITORABLE_PLUGIN_RETRY_MANIFESTRETRYINTERVAL", "10")
+
+	monitorable := NewMonitorable(s)
+
+	// Variant is enabled, even if manifest isn't available yet
+	valid, errors := monitorable.Validate("retry")
+	assert.True(t, valid)
+	assert.Len(t, errors, 1)
+
+	monitorable.Enable("retry")
+	handler := mockRouterGroup.Calls[0].Arguments.Get(1).(echo.HandlerFunc)
+
+	// Tile is registered once manifest is loaded
+	assert.Eventually(t, func() bool { return metadataRegistry.IsTileRegistered("ACME-RETRY") }, time.Second, 10*time.Millisecond)
+
+	variantMetadata, exists := metadataRegistry.GetTileVariantMetadata("ACME-RETRY", "retry")
+	if assert.True(t, exists) {
+		assert.True(t, variantMetadata.IsEnabled())
+		assert.Equal(t, "/api/v1/plugin/retry/acme-retry", *variantMetadata.RoutePath)
+	}
+
+	// Route dispatch to tiles of loaded manifest
+	for tileType, expectedStatus := range map[string]int{"acme-retry": http.StatusOK, "acme-unknown": http.StatusNotFound} {
+		e := echo.New()
+		req := httptest.NewRequest(echo.GET, "/api/v1/plugin/retry/"+tileType, nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+		ctx.SetParamNames("tileType")
+		ctx.SetParamValues(tileType)
+
+		err := handler(ctx)
+		if expectedStatus == http.StatusOK {
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+		} else if assert.Error(t, err) {
+			assert.Equal(t, expectedStatus, err.(*echo.HTTPError).Code)
+		}
+	}
+}
+
+func TestValidateManifest(t *testing.T) {
+	metadataRegistry := registry.NewRegistry()
+	metadataRegistry.RegisterTile("PING", versions.MinimalVersion, []coreModels.VariantName{coreModels.DefaultVariantName})
+	// Tile type already registered by another plugin
+	pluginTileTypes := map[coreModels.TileType]registry.TileEnabler{
+		"ACME-SHARED": metadataRegistry.RegisterTile("ACME-SHARED", versions.MinimalVersion, []coreModels.VariantName{"other"}),
+	}
+
+	for _, testcase := range []struct {
+		manifest *pluginModels.Manifest
+		valid    bool
+	}{
+		{&pluginModels.Manifest{}, true},
+		{&pluginModels.Manifest{Tiles: []pluginModels.TileManifest{{Type: "ACME-QUEUE"}}}, true},
+		{&pluginModels.Manifest{Tiles: []pluginModels.TileManifest{{Type: "GENERATE:ACME"}}}, false},
+		{&pluginModels.Manifest{Tiles: []pluginModels.TileManifest{{Type: "ACME"}, {Type: "ACME"}}}, false},
+		{&pluginModels.Manifest{Tiles: []pluginModels.TileManifest{{Type: "PING"}}}, false},
+		{&pluginModels.Manifest{Tiles: []pluginModels.TileManifest{{Type: "ACME-SHARED"}}}, true},
+		{&pluginModels.Manifest{Tiles: []pluginModels.TileManifest{{Type: "GROUP"}}}, false},
+		{&pluginModels.Manifest{Tiles: []pluginModels.TileManifest{{Type: "EMPTY"}}}, false},
+		{&pluginModels.Manifest{Tiles: []pluginModels.TileManifest{{Type: "ACME", Params: []pluginModels.ParamManifest{{Type: "string"}}}}}, false},
+		{&pluginModels.Manifest{Tiles: []pluginModels.TileManifest{{Type: "ACME", Params: []pluginModels.ParamManifest{{Name: "p", Type: "object"}}}}}, false},
+	} {
+		err := validateManifest(testcase.manifest, metadataRegistry, pluginTileTypes)
+		assert.Equal(t, testcase.valid, err == nil)
+	}
+}
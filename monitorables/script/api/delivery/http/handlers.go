@@ -0,0 +1,34 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/delivery"
+	"github.com/monitoror/monitoror/monitorables/script/api"
+	"github.com/monitoror/monitoror/monitorables/script/api/models"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ScriptDelivery struct {
+	scriptUsecase api.Usecase
+}
+
+func NewScriptDelivery(s api.Usecase) *ScriptDelivery {
+	return &ScriptDelivery{s}
+}
+
+func (h *ScriptDelivery) GetScript(c echo.Context) error {
+	// Bind / check Params
+	params := &models.ScriptParams{}
+	if err := delivery.BindAndValidateParams(c, params); err != nil {
+		return err
+	}
+
+	tile, err := h.scriptUsecase.Script(params)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, tile)
+}
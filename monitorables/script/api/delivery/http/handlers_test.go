@@ -0,0 +1,66 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/script/api"
+	"github.com/monitoror/monitoror/monitorables/script/api/mocks"
+	"github.com/monitoror/monitoror/monitorables/script/api/models"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	. "github.com/stretchr/testify/mock"
+)
+
+func initEcho() (ctx echo.Context, res *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(echo.GET, "/api/v1/script/script?args=disk&args=/var", nil)
+	res = httptest.NewRecorder()
+	ctx = e.NewContext(req, res)
+
+	return
+}
+
+func TestDelivery_ScriptHandler_Success(t *testing.T) {
+	// Init
+	ctx, res := initEcho()
+
+	tile := coreModels.NewTile(api.ScriptTileType)
+	tile.Status = coreModels.SuccessStatus
+
+	mockUsecase := new(mocks.Usecase)
+	mockUsecase.On("Script", &models.ScriptParams{Args: []string{"disk", "/var"}}).Return(tile, nil)
+	handler := NewScriptDelivery(mockUsecase)
+
+	// Expected
+	json, err := json.Marshal(tile)
+	assert.NoError(t, err, "unable to marshal tile")
+
+	// Test
+	if assert.NoError(t, handler.GetScript(ctx)) {
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(json), strings.TrimSpace(res.Body.String()))
+		mockUsecase.AssertNumberOfCalls(t, "Script", 1)
+		mockUsecase.AssertExpectations(t)
+	}
+}
+
+func TestDelivery_ScriptHandler_Error(t *testing.T) {
+	// Init
+	ctx, _ := initEcho()
+
+	mockUsecase := new(mocks.Usecase)
+	mockUsecase.On("Script", Anything).Return(nil, errors.New("script error"))
+	handler := NewScriptDelivery(mockUsecase)
+
+	// Test
+	assert.Error(t, handler.GetScript(ctx))
+	mockUsecase.AssertNumberOfCalls(t, "Script", 1)
+	mockUsecase.AssertExpectations(t)
+}
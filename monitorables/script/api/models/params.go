@@ -0,0 +1,15 @@
+//+build !faker
+
+package models
+
+import (
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/params"
+)
+
+type (
+	ScriptParams struct {
+		params.Default
+
+		Args []string `json:"args,omitempty" query:"args"`
+	}
+)
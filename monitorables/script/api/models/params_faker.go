@@ -0,0 +1,20 @@
+//+build faker
+
+package models
+
+import (
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/params"
+	coreModels "github.com/monitoror/monitoror/models"
+)
+
+type (
+	ScriptParams struct {
+		params.Default
+
+		Args []string `json:"args,omitempty" query:"args"`
+
+		Status      coreModels.TileStatus `json:"status" query:"status"`
+		Message     string                `json:"message" query:"message"`
+		ValueValues []string              `json:"valueValues" query:"valueValues"`
+	}
+)
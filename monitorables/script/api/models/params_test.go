@@ -0,0 +1,15 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/test"
+)
+
+func TestScriptParams_Validate(t *testing.T) {
+	param := &ScriptParams{}
+	test.AssertParams(t, param, 0)
+
+	param = &ScriptParams{Args: []string{"--path", "/var"}}
+	test.AssertParams(t, param, 0)
+}
@@ -0,0 +1,16 @@
+package models
+
+// Exit codes of script, mapped to tile status
+const (
+	SuccessExitCode = 0
+	WarningExitCode = 1
+	FailureExitCode = 2
+)
+
+type (
+	Script struct {
+		ExitCode int
+		Stdout   []byte
+		Stderr   []byte
+	}
+)
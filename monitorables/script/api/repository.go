@@ -0,0 +1,13 @@
+//go:generate mockery -name Repository
+
+package api
+
+import (
+	"github.com/monitoror/monitoror/monitorables/script/api/models"
+)
+
+type (
+	Repository interface {
+		ExecuteScript(args []string) (*models.Script, error)
+	}
+)
@@ -0,0 +1,18 @@
+//+build !windows
+
+package repository
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// setProcessGroup run command in its own process group, so processes started by the command can be killed with it
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+// killProcessGroup kill command and every process of its group
+func killProcessGroup(cmd *exec.Cmd) {
+	_ = syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+}
@@ -0,0 +1,15 @@
+//+build windows
+
+package repository
+
+import (
+	"os/exec"
+)
+
+// setProcessGroup does nothing on Windows, processes started by the command are not tracked
+func setProcessGroup(_ *exec.Cmd) {}
+
+// killProcessGroup kill command only
+func killProcessGroup(cmd *exec.Cmd) {
+	_ = cmd.Process.Kill()
+}
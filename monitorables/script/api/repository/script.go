@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"time"
+
+	"github.com/monitoror/monitoror/monitorables/script/api"
+	"github.com/monitoror/monitoror/monitorables/script/api/models"
+	"github.com/monitoror/monitoror/monitorables/script/config"
+)
+
+type (
+	scriptRepository struct {
+		config *config.Script
+
+		// semaphore limiting the number of scripts running at the same time
+		semaphore chan struct{}
+	}
+
+	// limitedBuffer keep at most limit bytes of output and discard the rest
+	limitedBuffer struct {
+		buffer    []byte
+		limit     int
+		truncated bool
+	}
+)
+
+var ErrOutputTooLarge = errors.New("output too large")
+
+func NewScriptRepository(conf *config.Script) api.Repository {
+	return &scriptRepository{
+		config:    conf,
+		semaphore: make(chan struct{}, conf.MaxConcurrency),
+	}
+}
+
+func (r *scriptRepository) ExecuteScript(args []string) (*models.Script, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(r.config.Timeout)*time.Millisecond)
+	defer cancel()
+
+	// Wait for a free slot, waiting time is included in timeout
+	select {
+	case r.semaphore <- struct{}{}:
+		defer func() { <-r.semaphore }()
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	stdout := &limitedBuffer{limit: r.config.MaxOutputSize}
+	stderr := &limitedBuffer{limit: r.config.MaxOutputSize}
+
+	// Command is never run through a shell, args can't inject anything (they are also checked by usecase)
+	cmd := exec.Command(r.config.Command, args...)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	setProcessGroup(cmd)
+
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	// Wait return once command and every process still holding its output have exited
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		// Kill the whole group, otherwise child processes keep running and Wait never return
+		killProcessGroup(cmd)
+		<-done
+		return nil, ctx.Err()
+	}
+
+	script := &models.Script{Stdout: stdout.buffer, Stderr: stderr.buffer}
+	if err != nil {
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) {
+			return nil, err
+		}
+		script.ExitCode = exitErr.ExitCode()
+	}
+
+	if stdout.truncated {
+		return nil, fmt.Errorf("%w, more than %d bytes", ErrOutputTooLarge, r.config.MaxOutputSize)
+	}
+
+	return script, nil
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if remaining := b.limit - len(b.buffer); remaining < len(p) {
+		b.truncated = true
+		if remaining > 0 {
+			b.buffer = append(b.buffer, p[:remaining]...)
+		}
+	} else {
+		b.buffer = append(b.buffer, p...)
+	}
+
+	// Always consume all bytes, otherwise the command fails on broken pipe
+	return len(p), nil
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/monitoror/monitoror/monitorables/script/config"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newRepository(command string) *scriptRepository {
+	conf := &config.Script{
+		Command:        command,
+		Timeout:        config.Default.Timeout,
+		MaxOutputSize:  config.Default.MaxOutputSize,
+		MaxConcurrency: config.Default.MaxConcurrency,
+	}
+	return NewScriptRepository(conf).(*scriptRepository)
+}
+
+func TestScriptRepository_ExecuteScript(t *testing.T) {
+	repository := newRepository("sh")
+
+	script, err := repository.ExecuteScript([]string{"-c", "echo $0; exit 2", "hello"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, 2, script.ExitCode)
+		assert.Equal(t, "hello\n", string(script.Stdout))
+	}
+}
+
+func TestScriptRepository_ExecuteScript_Timeout(t *testing.T) {
+	repository := newRepository("sleep")
+	repository.config.Timeout = 10
+
+	_, err := repository.ExecuteScript([]string{"1"})
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestScriptRepository_ExecuteScript_OutputTooLarge(t *testing.T) {
+	repository := newRepository("echo")
+	repository.config.MaxOutputSize = 4
+
+	_, err := repository.ExecuteScript([]string{"hello world"})
+	assert.True(t, errors.Is(err, ErrOutputTooLarge))
+}
+
+func TestScriptRepository_ExecuteScript_UnknownCommand(t *testing.T) {
+	repository := newRepository("/unknown/command")
+
+	_, err := repository.ExecuteScript(nil)
+	assert.Error(t, err)
+}
+
+func TestScriptRepository_ExecuteScript_Concurrency(t *testing.T) {
+	repository := newRepository("sleep")
+	repository.config.Timeout = 50
+	repository.semaphore = make(chan struct{}, 1)
+	repository.semaphore <- struct{}{}
+
+	// No free slot until timeout
+	_, err := repository.ExecuteScript([]string{"0"})
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestScriptRepository_ExecuteScript_TimeoutWithChildProcess(t *testing.T) {
+	repository := newRepository("sh")
+	repository.config.Timeout = 100
+
+	// Background sleep keeps stdout open after sh exits
+	start := time.Now()
+	_, err := repository.ExecuteScript([]string{"-c", "sleep 5 & echo started"})
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.True(t, time.Since(start) < 2*time.Second)
+}
@@ -0,0 +1,18 @@
+//go:generate mockery -name Usecase
+
+package api
+
+import (
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/script/api/models"
+)
+
+const (
+	ScriptTileType coreModels.TileType = "SCRIPT"
+)
+
+type (
+	Usecase interface {
+		Script(params *models.ScriptParams) (*coreModels.Tile, error)
+	}
+)
@@ -0,0 +1,114 @@
+//+build !faker
+
+package usecase
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/script/api"
+	"github.com/monitoror/monitoror/monitorables/script/api/models"
+)
+
+type (
+	scriptUsecase struct {
+		repository api.Repository
+
+		// command name, used as default label
+		command string
+		// argsRegexp every argument must match before running command
+		argsRegexp *regexp.Regexp
+	}
+)
+
+// outputStatuses allowed in script output
+var outputStatuses = map[coreModels.TileStatus]bool{
+	coreModels.SuccessStatus:        true,
+	coreModels.WarningStatus:        true,
+	coreModels.FailedStatus:         true,
+	coreModels.UnknownStatus:        true,
+	coreModels.DisabledStatus:       true,
+	coreModels.ActionRequiredStatus: true,
+}
+
+func NewScriptUsecase(repository api.Repository, command string, argsRegexp *regexp.Regexp) api.Usecase {
+	return &scriptUsecase{repository, command, argsRegexp}
+}
+
+func (su *scriptUsecase) Script(params *models.ScriptParams) (*coreModels.Tile, error) {
+	tile := coreModels.NewTile(api.ScriptTileType)
+	tile.Label = strings.Join(append([]string{filepath.Base(su.command)}, params.Args...), " ")
+
+	for _, arg := range params.Args {
+		if !su.argsRegexp.MatchString(arg) {
+			return nil, &coreModels.MonitororError{Tile: tile, Message: fmt.Sprintf("unauthorized argument %q", arg)}
+		}
+	}
+
+	script, err := su.repository.ExecuteScript(params.Args)
+	if err != nil {
+		return nil, &coreModels.MonitororError{Err: err, Tile: tile, Message: "unable to execute script"}
+	}
+
+	tile.Status = parseExitCode(script.ExitCode)
+
+	output := bytes.TrimSpace(script.Stdout)
+	if len(output) == 0 {
+		if tile.Status != coreModels.SuccessStatus {
+			tile.Message = string(bytes.TrimSpace(script.Stderr))
+		}
+		return tile, nil
+	}
+
+	// Output matching models.Tile shape, fields set by script override computed ones
+	if output[0] == '{' {
+		outputTile := &coreModels.Tile{}
+		if err := json.Unmarshal(output, outputTile); err == nil {
+			mergeTile(tile, outputTile)
+			return tile, nil
+		}
+	}
+
+	// Plain value
+	value := string(output)
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		tile.WithValue(coreModels.NumberUnit)
+	} else {
+		tile.WithValue(coreModels.RawUnit)
+	}
+	tile.Value.Values = append(tile.Value.Values, value)
+
+	return tile, nil
+}
+
+// parseExitCode map exit code of script to tile status
+func parseExitCode(exitCode int) coreModels.TileStatus {
+	switch exitCode {
+	case models.SuccessExitCode:
+		return coreModels.SuccessStatus
+	case models.WarningExitCode:
+		return coreModels.WarningStatus
+	case models.FailureExitCode:
+		return coreModels.FailedStatus
+	default:
+		return coreModels.UnknownStatus
+	}
+}
+
+func mergeTile(tile *coreModels.Tile, outputTile *coreModels.Tile) {
+	if outputTile.Label != "" {
+		tile.Label = outputTile.Label
+	}
+	if outputStatuses[outputTile.Status] {
+		tile.Status = outputTile.Status
+	}
+	tile.Message = outputTile.Message
+	tile.Value = outputTile.Value
+	tile.Build = outputTile.Build
+}
@@ -0,0 +1,61 @@
+//+build faker
+
+package usecase
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/faker"
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/script/api"
+	"github.com/monitoror/monitoror/monitorables/script/api/models"
+	"github.com/monitoror/monitoror/pkg/nonempty"
+)
+
+type (
+	scriptUsecase struct {
+		timeRefByArgs map[string]time.Time
+	}
+)
+
+var availableStatuses = faker.Statuses{
+	{coreModels.SuccessStatus, time.Second * 30},
+	{coreModels.WarningStatus, time.Second * 15},
+	{coreModels.FailedStatus, time.Second * 15},
+}
+
+func NewScriptUsecase() api.Usecase {
+	return &scriptUsecase{make(map[string]time.Time)}
+}
+
+func (su *scriptUsecase) Script(params *models.ScriptParams) (tile *coreModels.Tile, err error) {
+	tile = coreModels.NewTile(api.ScriptTileType)
+	tile.Label = strings.Join(append([]string{"script"}, params.Args...), " ")
+
+	// Code
+	tile.Status = nonempty.Struct(params.Status, su.computeStatus(params)).(coreModels.TileStatus)
+	tile.Message = params.Message
+
+	// Value
+	tile.WithValue(coreModels.NumberUnit)
+	if len(params.ValueValues) != 0 {
+		tile.Value.Values = params.ValueValues
+	} else {
+		tile.Value.Values = append(tile.Value.Values, fmt.Sprintf("%d", rand.Int31n(100)))
+	}
+
+	return
+}
+
+func (su *scriptUsecase) computeStatus(params *models.ScriptParams) coreModels.TileStatus {
+	key := strings.Join(params.Args, " ")
+	value, ok := su.timeRefByArgs[key]
+	if !ok {
+		su.timeRefByArgs[key] = faker.GetRefTime()
+	}
+
+	return faker.ComputeStatus(value, availableStatuses)
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/script/api"
+	"github.com/monitoror/monitoror/monitorables/script/api/mocks"
+	"github.com/monitoror/monitoror/monitorables/script/api/models"
+	"github.com/monitoror/monitoror/monitorables/script/config"
+
+	"github.com/stretchr/testify/assert"
+	. "github.com/stretchr/testify/mock"
+)
+
+func argsRegexp(t *testing.T) *regexp.Regexp {
+	argsRegexp, err := config.Default.GetArgsRegexp()
+	assert.NoError(t, err)
+	return argsRegexp
+}
+
+func TestUsecase_Script(t *testing.T) {
+	for _, testcase := range []struct {
+		script       *models.Script
+		expectedTile *coreModels.Tile
+	}{
+		{
+			script:       &models.Script{ExitCode: 0},
+			expectedTile: &coreModels.Tile{Label: "check.sh disk", Status: coreModels.SuccessStatus},
+		},
+		{
+			script:       &models.Script{ExitCode: 1, Stderr: []byte("disk almost full\n")},
+			expectedTile: &coreModels.Tile{Label: "check.sh disk", Status: coreModels.WarningStatus, Message: "disk almost full"},
+		},
+		{
+			script: &models.Script{ExitCode: 2, Stdout: []byte("98.5\n")},
+			expectedTile: &coreModels.Tile{Label: "check.sh disk", Status: coreModels.FailedStatus,
+				Value: &coreModels.TileValue{Unit: coreModels.NumberUnit, Values: []string{"98.5"}}},
+		},
+		{
+			script: &models.Script{ExitCode: 3, Stdout: []byte("unknown disk")},
+			expectedTile: &coreModels.Tile{Label: "check.sh disk", Status: coreModels.UnknownStatus,
+				Value: &coreModels.TileValue{Unit: coreModels.RawUnit, Values: []string{"unknown disk"}}},
+		},
+		{
+			script: &models.Script{ExitCode: 0, Stdout: []byte(`{"label": "disk", "status": "WARNING", "message": "80%", "value": {"values": ["80"], "unit": "PERCENT"}}`)},
+			expectedTile: &coreModels.Tile{Label: "disk", Status: coreModels.WarningStatus, Message: "80%",
+				Value: &coreModels.TileValue{Unit: coreModels.PercentUnit, Values: []string{"80"}}},
+		},
+		{
+			script:       &models.Script{ExitCode: 2, Stdout: []byte(`{"status": "RUNNING"}`)},
+			expectedTile: &coreModels.Tile{Label: "check.sh disk", Status: coreModels.FailedStatus},
+		},
+	} {
+		mockRepository := new(mocks.Repository)
+		mockRepository.On("ExecuteScript", []string{"disk"}).Return(testcase.script, nil)
+		usecase := NewScriptUsecase(mockRepository, "/opt/checks/check.sh", argsRegexp(t))
+
+		tile, err := usecase.Script(&models.ScriptParams{Args: []string{"disk"}})
+		if assert.NoError(t, err) {
+			testcase.expectedTile.Type = api.ScriptTileType
+			assert.Equal(t, testcase.expectedTile, tile)
+			mockRepository.AssertExpectations(t)
+		}
+	}
+}
+
+func TestUsecase_Script_Error(t *testing.T) {
+	mockRepository := new(mocks.Repository)
+	mockRepository.On("ExecuteScript", Anything).Return(nil, errors.New("boom"))
+	usecase := NewScriptUsecase(mockRepository, "check.sh", argsRegexp(t))
+
+	tile, err := usecase.Script(&models.ScriptParams{})
+	if assert.Error(t, err) {
+		assert.Nil(t, tile)
+		assert.IsType(t, &coreModels.MonitororError{}, err)
+		assert.Equal(t, "unable to execute script", err.Error())
+	}
+}
+
+func TestUsecase_Script_UnauthorizedArgs(t *testing.T) {
+	for _, arg := range []string{"--output=/etc/passwd", "-v", "disk usage", "$(id)", ""} {
+		mockRepository := new(mocks.Repository)
+		usecase := NewScriptUsecase(mockRepository, "check.sh", argsRegexp(t))
+
+		tile, err := usecase.Script(&models.ScriptParams{Args: []string{"disk", arg}})
+		if assert.Error(t, err, arg) {
+			assert.Nil(t, tile)
+			assert.IsType(t, &coreModels.MonitororError{}, err)
+			assert.Equal(t, fmt.Sprintf("unauthorized argument %q", arg), err.Error())
+			mockRepository.AssertNotCalled(t, "ExecuteScript", Anything)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+)
+
+type (
+	Script struct {
+		Command        string // Path of the whitelisted command or script, never set in dashboard config
+		ArgsPattern    string // Regex that every argument set in dashboard config must match entirely
+		Timeout        int    `validate:"gte=0"` // In Millisecond
+		MaxOutputSize  int    `validate:"gt=0"`  // In Bytes
+		MaxConcurrency int    `validate:"gt=0"`
+	}
+)
+
+var Default = &Script{
+	Command: "",
+	// No whitespace and no leading "-", arguments can't be read as options by the command
+	ArgsPattern:    `[A-Za-z0-9_./:@=+,][A-Za-z0-9_./:@=+,-]*`,
+	Timeout:        10000,
+	MaxOutputSize:  64 * 1024,
+	MaxConcurrency: 4,
+}
+
+// GetArgsRegexp return ArgsPattern anchored to match whole argument
+func (s *Script) GetArgsRegexp() (*regexp.Regexp, error) {
+	argsRegexp, err := regexp.Compile(fmt.Sprintf("^(?:%s)$", s.ArgsPattern))
+	if err != nil {
+		return nil, fmt.Errorf("invalid args pattern %q: %w", s.ArgsPattern, err)
+	}
+	return argsRegexp, nil
+}
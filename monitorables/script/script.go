@@ -0,0 +1,92 @@
+//+build !faker
+
+package script
+
+import (
+	"fmt"
+	"os/exec"
+
+	"github.com/monitoror/monitoror/api/config/versions"
+	pkgMonitorable "github.com/monitoror/monitoror/internal/pkg/monitorable"
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/script/api"
+	scriptDelivery "github.com/monitoror/monitoror/monitorables/script/api/delivery/http"
+	scriptModels "github.com/monitoror/monitoror/monitorables/script/api/models"
+	scriptRepository "github.com/monitoror/monitoror/monitorables/script/api/repository"
+	scriptUsecase "github.com/monitoror/monitoror/monitorables/script/api/usecase"
+	scriptConfig "github.com/monitoror/monitoror/monitorables/script/config"
+	"github.com/monitoror/monitoror/registry"
+	"github.com/monitoror/monitoror/store"
+)
+
+type Monitorable struct {
+	store *store.Store
+
+	config map[coreModels.VariantName]*scriptConfig.Script
+
+	// Config tile settings
+	scriptTileEnabler registry.TileEnabler
+}
+
+func NewMonitorable(store *store.Store) *Monitorable {
+	m := &Monitorable{}
+	m.store = store
+	m.config = make(map[coreModels.VariantName]*scriptConfig.Script)
+
+	// Load core config from env
+	pkgMonitorable.LoadConfig(&m.config, scriptConfig.Default)
+
+	// Register Monitorable Tile in config manager
+	m.scriptTileEnabler = store.Registry.RegisterTile(api.ScriptTileType, versions.MinimalVersion, m.GetVariantsNames())
+
+	return m
+}
+
+func (m *Monitorable) GetDisplayName() string {
+	return "Script"
+}
+
+func (m *Monitorable) GetVariantsNames() []coreModels.VariantName {
+	return pkgMonitorable.GetVariantsNames(m.config)
+}
+
+func (m *Monitorable) Validate(variantName coreModels.VariantName) (bool, []error) {
+	conf := m.config[variantName]
+
+	// No configuration set
+	if conf.Command == "" {
+		return false, nil
+	}
+
+	// Validate Config
+	if errors := pkgMonitorable.ValidateConfig(conf, variantName); errors != nil {
+		return false, errors
+	}
+
+	if _, err := conf.GetArgsRegexp(); err != nil {
+		return false, []error{err}
+	}
+
+	if _, err := exec.LookPath(conf.Command); err != nil {
+		return false, []error{fmt.Errorf("unable to find command %q: %w", conf.Command, err)}
+	}
+
+	return true, nil
+}
+
+func (m *Monitorable) Enable(variantName coreModels.VariantName) {
+	conf := m.config[variantName]
+
+	argsRegexp, _ := conf.GetArgsRegexp() // Already checked in Validate
+
+	repository := scriptRepository.NewScriptRepository(conf)
+	usecase := scriptUsecase.NewScriptUsecase(repository, conf.Command, argsRegexp)
+	delivery := scriptDelivery.NewScriptDelivery(usecase)
+
+	// EnableTile route to echo
+	routeGroup := m.store.MonitorableRouter.Group("/script", variantName)
+	route := routeGroup.GET("/script", delivery.GetScript)
+
+	// EnableTile data for config hydration
+	m.scriptTileEnabler.Enable(variantName, &scriptModels.ScriptParams{}, route.Path)
+}
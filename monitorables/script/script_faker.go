@@ -0,0 +1,48 @@
+//+build faker
+
+package script
+
+import (
+	"github.com/monitoror/monitoror/api/config/versions"
+	"github.com/monitoror/monitoror/internal/pkg/monitorable"
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/script/api"
+	scriptDelivery "github.com/monitoror/monitoror/monitorables/script/api/delivery/http"
+	scriptModels "github.com/monitoror/monitoror/monitorables/script/api/models"
+	scriptUsecase "github.com/monitoror/monitoror/monitorables/script/api/usecase"
+	"github.com/monitoror/monitoror/registry"
+	"github.com/monitoror/monitoror/store"
+)
+
+type Monitorable struct {
+	monitorable.DefaultMonitorableFaker
+
+	store *store.Store
+
+	// Config tile settings
+	scriptTileEnabler registry.TileEnabler
+}
+
+func NewMonitorable(store *store.Store) *Monitorable {
+	m := &Monitorable{}
+	m.store = store
+
+	// Register Monitorable Tile in config manager
+	m.scriptTileEnabler = store.Registry.RegisterTile(api.ScriptTileType, versions.MinimalVersion, m.GetVariantsNames())
+
+	return m
+}
+
+func (m *Monitorable) GetDisplayName() string { return "Script" }
+
+func (m *Monitorable) Enable(variantName coreModels.VariantName) {
+	usecase := scriptUsecase.NewScriptUsecase()
+	delivery := scriptDelivery.NewScriptDelivery(usecase)
+
+	// EnableTile route to echo
+	routeGroup := m.store.MonitorableRouter.Group("/script", variantName)
+	route := routeGroup.GET("/script", delivery.GetScript)
+
+	// EnableTile data for config hydration
+	m.scriptTileEnabler.Enable(variantName, &scriptModels.ScriptParams{}, route.Path)
+}
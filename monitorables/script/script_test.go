@@ -0,0 +1,59 @@
+package script
+
+import (
+	"os"
+	"testing"
+
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMonitorable(t *testing.T) {
+	// init Store
+	store, mockMonitorableHelper := test.InitMockAndStore()
+
+	// init Env
+	_ = os.Setenv("MO_MONITORABLE_SCRIPT_COMMAND", "sh")
+	// Wrong Timeout
+	_ = os.Setenv("MO_MONITORABLE_SCRIPT_VARIANT0_COMMAND", "sh")
+	_ = os.Setenv("MO_MONITORABLE_SCRIPT_VARIANT0_TIMEOUT", "-1000")
+	// Unknown command
+	_ = os.Setenv("MO_MONITORABLE_SCRIPT_VARIANT1_COMMAND", "/unknown/command")
+	// Not configured
+	_ = os.Setenv("MO_MONITORABLE_SCRIPT_VARIANT2_TIMEOUT", "1000")
+	// Wrong args pattern
+	_ = os.Setenv("MO_MONITORABLE_SCRIPT_VARIANT3_COMMAND", "sh")
+	_ = os.Setenv("MO_MONITORABLE_SCRIPT_VARIANT3_ARGSPATTERN", "[a-z")
+
+	// NewMonitorable
+	monitorable := NewMonitorable(store)
+	assert.NotNil(t, monitorable)
+
+	// GetDisplayName
+	assert.NotNil(t, monitorable.GetDisplayName())
+
+	// GetVariantsNames and check
+	if assert.Len(t, monitorable.GetVariantsNames(), 5) {
+		_, errors := monitorable.Validate("variant0")
+		assert.NotEmpty(t, errors)
+		_, errors = monitorable.Validate("variant1")
+		assert.NotEmpty(t, errors)
+		valid, errors := monitorable.Validate("variant2")
+		assert.False(t, valid)
+		assert.Empty(t, errors)
+		_, errors = monitorable.Validate("variant3")
+		assert.NotEmpty(t, errors)
+	}
+
+	// Enable
+	for _, variantName := range monitorable.GetVariantsNames() {
+		if valid, _ := monitorable.Validate(variantName); valid {
+			monitorable.Enable(variantName)
+		}
+	}
+
+	// Test calls
+	mockMonitorableHelper.RouterAssertNumberOfCalls(t, 1, 1)
+	mockMonitorableHelper.TileSettingsManagerAssertNumberOfCalls(t, 1, 0, 1, 0)
+}
@@ -0,0 +1,34 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/delivery"
+	"github.com/monitoror/monitoror/monitorables/tlscertificate/api"
+	"github.com/monitoror/monitoror/monitorables/tlscertificate/api/models"
+
+	"github.com/labstack/echo/v4"
+)
+
+type TLSCertificateDelivery struct {
+	tlsCertificateUsecase api.Usecase
+}
+
+func NewTLSCertificateDelivery(u api.Usecase) *TLSCertificateDelivery {
+	return &TLSCertificateDelivery{u}
+}
+
+func (h *TLSCertificateDelivery) GetTLSCertificate(c echo.Context) error {
+	// Bind / check Params
+	params := &models.TLSCertificateParams{}
+	if err := delivery.BindAndValidateParams(c, params); err != nil {
+		return err
+	}
+
+	tile, err := h.tlsCertificateUsecase.TLSCertificate(params)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, tile)
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/monitoror/monitoror/internal/pkg/validator"
+)
+
+type (
+	TLSCertificateGeneratorParams struct {
+		// Hosts as "hostname" or "hostname:port"
+		Hosts    []string `json:"hosts" query:"hosts" validate:"notempty"`
+		StartTLS StartTLS `json:"startTLS,omitempty" query:"startTLS" validate:"omitempty,oneof=SMTP IMAP POSTGRES"`
+
+		WarningDays *int `json:"warningDays,omitempty" query:"warningDays" validate:"omitempty,gte=0"`
+		FailureDays *int `json:"failureDays,omitempty" query:"failureDays" validate:"omitempty,gte=0"`
+	}
+)
+
+func (p *TLSCertificateGeneratorParams) Validate() []validator.Error {
+	var errors []validator.Error
+	for i, host := range p.Hosts {
+		if _, _, err := ParseHost(host); err != nil {
+			errors = append(errors, validator.NewDefaultError(fmt.Sprintf("Hosts[%d]", i), `"hostname" or "hostname:port"`))
+		}
+	}
+
+	return append(errors, validateDays(p.WarningDays, p.FailureDays)...)
+}
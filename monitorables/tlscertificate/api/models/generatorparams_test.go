@@ -0,0 +1,49 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/test"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTLSCertificateGeneratorParams_Validate(t *testing.T) {
+	param := &TLSCertificateGeneratorParams{}
+	test.AssertParams(t, param, 1)
+
+	param = &TLSCertificateGeneratorParams{Hosts: []string{"example.com", "example.com:0", "example.com:https"}}
+	test.AssertParams(t, param, 2)
+
+	param = &TLSCertificateGeneratorParams{Hosts: []string{"example.com"}, WarningDays: pointer.ToInt(1), FailureDays: pointer.ToInt(2)}
+	test.AssertParams(t, param, 1)
+
+	param = &TLSCertificateGeneratorParams{Hosts: []string{"example.com", "example.com:8443", "[::1]:443"}, StartTLS: IMAPStartTLS}
+	test.AssertParams(t, param, 0)
+}
+
+func TestParseHost(t *testing.T) {
+	for _, testcase := range []struct {
+		host     string
+		hostname string
+		port     int
+		err      bool
+	}{
+		{host: "example.com", hostname: "example.com"},
+		{host: "example.com:8443", hostname: "example.com", port: 8443},
+		{host: "[::1]:443", hostname: "::1", port: 443},
+		{host: "[::1]", hostname: "::1"},
+		{host: "example.com:70000", err: true},
+		{host: "example.com:https", err: true},
+		{host: "example.com:443:443", err: true},
+	} {
+		hostname, port, err := ParseHost(testcase.host)
+		if testcase.err {
+			assert.Error(t, err, testcase.host)
+		} else if assert.NoError(t, err, testcase.host) {
+			assert.Equal(t, testcase.hostname, hostname)
+			assert.Equal(t, testcase.port, port)
+		}
+	}
+}
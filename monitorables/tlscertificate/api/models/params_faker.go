@@ -0,0 +1,41 @@
+//+build faker
+
+package models
+
+import (
+	"github.com/monitoror/monitoror/internal/pkg/validator"
+	coreModels "github.com/monitoror/monitoror/models"
+)
+
+type (
+	TLSCertificateParams struct {
+		Hostname   string   `json:"hostname" query:"hostname" validate:"required"`
+		Port       int      `json:"port,omitempty" query:"port" validate:"omitempty,gt=0,lte=65535"`
+		ServerName string   `json:"serverName,omitempty" query:"serverName"`
+		StartTLS   StartTLS `json:"startTLS,omitempty" query:"startTLS" validate:"omitempty,oneof=SMTP IMAP POSTGRES"`
+
+		WarningDays *int `json:"warningDays,omitempty" query:"warningDays" validate:"omitempty,gte=0"`
+		FailureDays *int `json:"failureDays,omitempty" query:"failureDays" validate:"omitempty,gte=0"`
+
+		Status      coreModels.TileStatus `json:"status" query:"status"`
+		ValueValues []string              `json:"valueValues" query:"valueValues"`
+	}
+)
+
+func (p *TLSCertificateParams) Validate() []validator.Error {
+	return validateDays(p.WarningDays, p.FailureDays)
+}
+
+func (p *TLSCertificateParams) GetPort() int { return getPortWithDefault(p.Port, p.StartTLS) }
+
+// GetServerName return name sent with SNI and checked in certificate, Hostname by default
+func (p *TLSCertificateParams) GetServerName() string {
+	if p.ServerName == "" {
+		return p.Hostname
+	}
+	return p.ServerName
+}
+
+func (p *TLSCertificateParams) GetDays() (warning int, failure int) {
+	return getDaysWithDefault(p.WarningDays, DefaultWarningDays), getDaysWithDefault(p.FailureDays, DefaultFailureDays)
+}
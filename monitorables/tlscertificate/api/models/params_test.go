@@ -0,0 +1,46 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/test"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTLSCertificateParams_Validate(t *testing.T) {
+	param := &TLSCertificateParams{}
+	test.AssertParams(t, param, 1)
+
+	param = &TLSCertificateParams{Hostname: "test", Port: 70000}
+	test.AssertParams(t, param, 1)
+
+	param = &TLSCertificateParams{Hostname: "test", StartTLS: "FTP"}
+	test.AssertParams(t, param, 1)
+
+	param = &TLSCertificateParams{Hostname: "test", WarningDays: pointer.ToInt(7), FailureDays: pointer.ToInt(30)}
+	test.AssertParams(t, param, 1)
+
+	param = &TLSCertificateParams{Hostname: "test"}
+	test.AssertParams(t, param, 0)
+
+	param = &TLSCertificateParams{Hostname: "test", Port: 25, StartTLS: SMTPStartTLS, WarningDays: pointer.ToInt(10), FailureDays: pointer.ToInt(0)}
+	test.AssertParams(t, param, 0)
+}
+
+func TestTLSCertificateParams_Getters(t *testing.T) {
+	param := &TLSCertificateParams{Hostname: "test"}
+	assert.Equal(t, 443, param.GetPort())
+	assert.Equal(t, "test", param.GetServerName())
+	warning, failure := param.GetDays()
+	assert.Equal(t, DefaultWarningDays, warning)
+	assert.Equal(t, DefaultFailureDays, failure)
+
+	param = &TLSCertificateParams{Hostname: "test", ServerName: "monitoror.example.com", StartTLS: PostgresStartTLS, FailureDays: pointer.ToInt(0)}
+	assert.Equal(t, 5432, param.GetPort())
+	assert.Equal(t, "monitoror.example.com", param.GetServerName())
+	warning, failure = param.GetDays()
+	assert.Equal(t, DefaultWarningDays, warning)
+	assert.Equal(t, 0, failure)
+}
@@ -0,0 +1,73 @@
+package models
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/monitoror/monitoror/internal/pkg/validator"
+)
+
+type StartTLS string
+
+const (
+	SMTPStartTLS     StartTLS = "SMTP"
+	IMAPStartTLS     StartTLS = "IMAP"
+	PostgresStartTLS StartTLS = "POSTGRES"
+)
+
+const (
+	DefaultWarningDays = 30
+	DefaultFailureDays = 7
+)
+
+// defaultPorts by StartTLS protocol, "" is plain TLS
+var defaultPorts = map[StartTLS]int{
+	"":               443,
+	SMTPStartTLS:     587,
+	IMAPStartTLS:     143,
+	PostgresStartTLS: 5432,
+}
+
+// ParseHost split "hostname" or "hostname:port" (ex: "[::1]:443"), port is 0 when missing
+func ParseHost(host string) (hostname string, port int, err error) {
+	hostname, rawPort, err := net.SplitHostPort(host)
+	if err != nil {
+		// Without port
+		if hostname, _, err = net.SplitHostPort(host + ":0"); err != nil {
+			return "", 0, fmt.Errorf("invalid host %q", host)
+		}
+	} else if port, err = strconv.Atoi(rawPort); err != nil || port <= 0 || port > 65535 {
+		return "", 0, fmt.Errorf("invalid port in host %q", host)
+	}
+
+	if hostname == "" {
+		return "", 0, fmt.Errorf("missing hostname in host %q", host)
+	}
+
+	return hostname, port, nil
+}
+
+func getPortWithDefault(port int, startTLS StartTLS) int {
+	if port == 0 {
+		return defaultPorts[startTLS]
+	}
+	return port
+}
+
+func validateDays(warningDays, failureDays *int) []validator.Error {
+	warning := getDaysWithDefault(warningDays, DefaultWarningDays)
+	failure := getDaysWithDefault(failureDays, DefaultFailureDays)
+	if failure > warning {
+		return []validator.Error{validator.NewDefaultError("FailureDays", "failureDays <= warningDays")}
+	}
+
+	return nil
+}
+
+func getDaysWithDefault(days *int, defaultDays int) int {
+	if days == nil {
+		return defaultDays
+	}
+	return *days
+}
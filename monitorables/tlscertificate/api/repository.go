@@ -0,0 +1,16 @@
+//go:generate mockery -name Repository
+
+package api
+
+import (
+	"crypto/x509"
+
+	"github.com/monitoror/monitoror/monitorables/tlscertificate/api/models"
+)
+
+type (
+	Repository interface {
+		// GetCertificates return certificates chain presented by server, leaf certificate first
+		GetCertificates(hostname string, port int, serverName string, startTLS models.StartTLS) ([]*x509.Certificate, error)
+	}
+)
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"bufio"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/monitoror/monitoror/monitorables/tlscertificate/api"
+	"github.com/monitoror/monitoror/monitorables/tlscertificate/api/models"
+	"github.com/monitoror/monitoror/monitorables/tlscertificate/config"
+)
+
+type (
+	tlsCertificateRepository struct {
+		config  *config.TLSCertificate
+		timeout time.Duration
+	}
+)
+
+// postgresSSLRequestCode is sent by postgres clients to ask for TLS before startup message
+const postgresSSLRequestCode = 80877103
+
+func NewTLSCertificateRepository(conf *config.TLSCertificate) api.Repository {
+	return &tlsCertificateRepository{conf, time.Millisecond * time.Duration(conf.Timeout)}
+}
+
+func (r *tlsCertificateRepository) GetCertificates(hostname string, port int, serverName string, startTLS models.StartTLS) ([]*x509.Certificate, error) {
+	conn, err := net.DialTimeout("tcp", net.JoinHostPort(hostname, strconv.Itoa(port)), r.timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(r.timeout))
+
+	// Chain is verified by usecase to report why it is invalid
+	tlsConfig := &tls.Config{ServerName: serverName, InsecureSkipVerify: true}
+
+	var state tls.ConnectionState
+	switch startTLS {
+	case models.SMTPStartTLS:
+		state, err = smtpStartTLS(conn, tlsConfig)
+	case models.IMAPStartTLS:
+		state, err = imapStartTLS(conn, tlsConfig)
+	case models.PostgresStartTLS:
+		state, err = postgresStartTLS(conn, tlsConfig)
+	default:
+		state, err = handshake(conn, tlsConfig)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(state.PeerCertificates) == 0 {
+		return nil, errors.New("no certificate presented by server")
+	}
+
+	return state.PeerCertificates, nil
+}
+
+func handshake(conn net.Conn, tlsConfig *tls.Config) (tls.ConnectionState, error) {
+	tlsConn := tls.Client(conn, tlsConfig)
+	if err := tlsConn.Handshake(); err != nil {
+		return tls.ConnectionState{}, err
+	}
+	return tlsConn.ConnectionState(), nil
+}
+
+func smtpStartTLS(conn net.Conn, tlsConfig *tls.Config) (tls.ConnectionState, error) {
+	client, err := smtp.NewClient(conn, tlsConfig.ServerName)
+	if err != nil {
+		return tls.ConnectionState{}, err
+	}
+	if err = client.StartTLS(tlsConfig); err != nil {
+		return tls.ConnectionState{}, err
+	}
+
+	state, _ := client.TLSConnectionState()
+	_ = client.Quit()
+
+	return state, nil
+}
+
+func imapStartTLS(conn net.Conn, tlsConfig *tls.Config) (tls.ConnectionState, error) {
+	reader := bufio.NewReader(conn)
+
+	// Greeting
+	if line, err := reader.ReadString('\n'); err != nil {
+		return tls.ConnectionState{}, err
+	} else if !strings.HasPrefix(line, "* OK") {
+		return tls.ConnectionState{}, fmt.Errorf("unexpected imap greeting: %s", strings.TrimSpace(line))
+	}
+
+	if _, err := fmt.Fprint(conn, "a1 STARTTLS\r\n"); err != nil {
+		return tls.ConnectionState{}, err
+	}
+
+	// Skip untagged responses until tagged one
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return tls.ConnectionState{}, err
+		}
+		if strings.HasPrefix(line, "a1 ") {
+			if !strings.HasPrefix(line, "a1 OK") {
+				return tls.ConnectionState{}, fmt.Errorf("imap STARTTLS refused: %s", strings.TrimSpace(line))
+			}
+			break
+		}
+	}
+
+	return handshake(conn, tlsConfig)
+}
+
+func postgresStartTLS(conn net.Conn, tlsConfig *tls.Config) (tls.ConnectionState, error) {
+	request := make([]byte, 8)
+	binary.BigEndian.PutUint32(request[0:4], 8)
+	binary.BigEndian.PutUint32(request[4:8], postgresSSLRequestCode)
+	if _, err := conn.Write(request); err != nil {
+		return tls.ConnectionState{}, err
+	}
+
+	response := make([]byte, 1)
+	if _, err := conn.Read(response); err != nil {
+		return tls.ConnectionState{}, err
+	}
+	if response[0] != 'S' {
+		return tls.ConnectionState{}, errors.New("postgres server doesn't support SSL")
+	}
+
+	return handshake(conn, tlsConfig)
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"bufio"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/monitoror/monitoror/monitorables/tlscertificate/api/models"
+	"github.com/monitoror/monitoror/monitorables/tlscertificate/config"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// serveStartTLS start a tcp server running negotiate on each connection before TLS handshake
+func serveStartTLS(t *testing.T, tlsConfig *tls.Config, negotiate func(conn net.Conn) bool) (string, int) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				if negotiate(conn) {
+					_ = tls.Server(conn, tlsConfig).Handshake()
+				}
+			}()
+		}
+	}()
+
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	p, _ := strconv.Atoi(port)
+	return host, p
+}
+
+func initServer(t *testing.T) (*httptest.Server, string, int) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	t.Cleanup(server.Close)
+
+	host, port, _ := net.SplitHostPort(server.Listener.Addr().String())
+	p, _ := strconv.Atoi(port)
+	return server, host, p
+}
+
+func TestRepository_GetCertificates(t *testing.T) {
+	server, host, port := initServer(t)
+	repository := NewTLSCertificateRepository(&config.TLSCertificate{Timeout: 2000})
+
+	certificates, err := repository.GetCertificates(host, port, "example.com", "")
+	if assert.NoError(t, err) && assert.NotEmpty(t, certificates) {
+		assert.Equal(t, server.Certificate().Raw, certificates[0].Raw)
+	}
+}
+
+func TestRepository_GetCertificates_Error(t *testing.T) {
+	_, host, port := initServer(t)
+	repository := NewTLSCertificateRepository(&config.TLSCertificate{Timeout: 2000})
+
+	// Server doesn't speak postgres
+	_, err := repository.GetCertificates(host, port, "example.com", models.PostgresStartTLS)
+	assert.Error(t, err)
+
+	// Nothing listening
+	listener, _ := net.Listen("tcp", "127.0.0.1:0")
+	closedPort := listener.Addr().(*net.TCPAddr).Port
+	_ = listener.Close()
+	_, err = repository.GetCertificates("127.0.0.1", closedPort, "example.com", "")
+	assert.Error(t, err)
+}
+
+func TestRepository_GetCertificates_IMAP(t *testing.T) {
+	server, _, _ := initServer(t)
+	host, port := serveStartTLS(t, server.TLS, func(conn net.Conn) bool {
+		_, _ = conn.Write([]byte("* OK IMAP4rev1 ready\r\n"))
+		line, err := bufio.NewReader(conn).ReadString('\n')
+		if err != nil || line != "a1 STARTTLS\r\n" {
+			_, _ = conn.Write([]byte("a1 BAD\r\n"))
+			return false
+		}
+		_, _ = conn.Write([]byte("* CAPABILITY IMAP4rev1\r\na1 OK Begin TLS negotiation now\r\n"))
+		return true
+	})
+	repository := NewTLSCertificateRepository(&config.TLSCertificate{Timeout: 2000})
+
+	certificates, err := repository.GetCertificates(host, port, "example.com", models.IMAPStartTLS)
+	if assert.NoError(t, err) && assert.NotEmpty(t, certificates) {
+		assert.Equal(t, server.Certificate().Raw, certificates[0].Raw)
+	}
+}
+
+func TestRepository_GetCertificates_Postgres(t *testing.T) {
+	server, _, _ := initServer(t)
+	expectedRequest := []byte{0, 0, 0, 8, 4, 210, 22, 47}
+
+	for _, testcase := range []struct {
+		response byte
+		err      bool
+	}{
+		{response: 'S'},
+		{response: 'N', err: true},
+	} {
+		response := testcase.response
+		host, port := serveStartTLS(t, server.TLS, func(conn net.Conn) bool {
+			request := make([]byte, 8)
+			if _, err := conn.Read(request); err != nil || string(request) != string(expectedRequest) {
+				return false
+			}
+			_, _ = conn.Write([]byte{response})
+			return response == 'S'
+		})
+		repository := NewTLSCertificateRepository(&config.TLSCertificate{Timeout: 2000})
+
+		certificates, err := repository.GetCertificates(host, port, "example.com", models.PostgresStartTLS)
+		if testcase.err {
+			assert.Error(t, err)
+		} else if assert.NoError(t, err) && assert.NotEmpty(t, certificates) {
+			assert.Equal(t, server.Certificate().Raw, certificates[0].Raw)
+		}
+	}
+}
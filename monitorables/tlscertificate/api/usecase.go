@@ -0,0 +1,20 @@
+//go:generate mockery -name Usecase
+
+package api
+
+import (
+	uiConfigModels "github.com/monitoror/monitoror/api/config/models"
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/tlscertificate/api/models"
+)
+
+const (
+	TLSCertificateTileType coreModels.TileType = "TLS-CERTIFICATE"
+)
+
+type (
+	Usecase interface {
+		TLSCertificate(params *models.TLSCertificateParams) (*coreModels.Tile, error)
+		TLSCertificateGenerator(params interface{}) ([]uiConfigModels.GeneratedTile, error)
+	}
+)
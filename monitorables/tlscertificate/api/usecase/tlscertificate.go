@@ -0,0 +1,115 @@
+//+build !faker
+
+package usecase
+
+import (
+	"crypto/x509"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	uiConfigModels "github.com/monitoror/monitoror/api/config/models"
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/tlscertificate/api"
+	"github.com/monitoror/monitoror/monitorables/tlscertificate/api/models"
+)
+
+type (
+	tlsCertificateUsecase struct {
+		repository api.Repository
+
+		// roots used to verify chains, nil for system certificates
+		roots *x509.CertPool
+	}
+)
+
+func NewTLSCertificateUsecase(repository api.Repository, roots *x509.CertPool) api.Usecase {
+	return &tlsCertificateUsecase{repository, roots}
+}
+
+func (tu *tlsCertificateUsecase) TLSCertificate(params *models.TLSCertificateParams) (*coreModels.Tile, error) {
+	tile := coreModels.NewTile(api.TLSCertificateTileType)
+	tile.Label = fmt.Sprintf("%s:%d", params.Hostname, params.GetPort())
+
+	certificates, err := tu.repository.GetCertificates(params.Hostname, params.GetPort(), params.GetServerName(), params.StartTLS)
+	if err != nil {
+		return nil, &coreModels.MonitororError{Err: err, Tile: tile, Message: fmt.Sprintf("unable to get certificate of %s", tile.Label)}
+	}
+
+	leaf := certificates[0]
+	days := int(math.Floor(time.Until(leaf.NotAfter).Hours() / 24))
+
+	tile.WithValue(coreModels.NumberUnit)
+	tile.Value.Values = []string{strconv.Itoa(days)}
+	tile.Value.Suffix = " days"
+
+	if days < 0 {
+		tile.Status = coreModels.FailedStatus
+		tile.Message = fmt.Sprintf("expired on %s", leaf.NotAfter.Format("2006-01-02"))
+		return tile, nil
+	}
+
+	if message := tu.verify(certificates, params.GetServerName()); message != "" {
+		tile.Status = coreModels.FailedStatus
+		tile.Message = message
+		return tile, nil
+	}
+
+	warningDays, failureDays := params.GetDays()
+	switch {
+	case days < failureDays:
+		tile.Status = coreModels.FailedStatus
+	case days < warningDays:
+		tile.Status = coreModels.WarningStatus
+	default:
+		tile.Status = coreModels.SuccessStatus
+	}
+	if tile.Status != coreModels.SuccessStatus {
+		tile.Message = fmt.Sprintf("expires on %s", leaf.NotAfter.Format("2006-01-02"))
+	}
+
+	return tile, nil
+}
+
+func (tu *tlsCertificateUsecase) TLSCertificateGenerator(params interface{}) ([]uiConfigModels.GeneratedTile, error) {
+	gParams := params.(*models.TLSCertificateGeneratorParams)
+
+	var results []uiConfigModels.GeneratedTile
+	for _, host := range gParams.Hosts {
+		hostname, port, _ := models.ParseHost(host) // Already validate by TLSCertificateGeneratorParams.Validate
+
+		results = append(results, uiConfigModels.GeneratedTile{
+			Label: host,
+			Params: &models.TLSCertificateParams{
+				Hostname:    hostname,
+				Port:        port,
+				StartTLS:    gParams.StartTLS,
+				WarningDays: gParams.WarningDays,
+				FailureDays: gParams.FailureDays,
+			},
+		})
+	}
+
+	return results, nil
+}
+
+// verify check chain trust and hostname, return a message when chain is invalid
+func (tu *tlsCertificateUsecase) verify(certificates []*x509.Certificate, serverName string) string {
+	intermediates := x509.NewCertPool()
+	for _, certificate := range certificates[1:] {
+		intermediates.AddCert(certificate)
+	}
+
+	_, err := certificates[0].Verify(x509.VerifyOptions{DNSName: serverName, Roots: tu.roots, Intermediates: intermediates})
+	switch err := err.(type) {
+	case nil:
+		return ""
+	case x509.HostnameError:
+		return fmt.Sprintf("certificate is not valid for %s", serverName)
+	case x509.UnknownAuthorityError:
+		return "certificate signed by unknown authority"
+	default:
+		return fmt.Sprintf("invalid certificate: %v", err)
+	}
+}
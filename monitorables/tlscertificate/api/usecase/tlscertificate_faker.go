@@ -0,0 +1,67 @@
+//+build faker
+
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	uiConfigModels "github.com/monitoror/monitoror/api/config/models"
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/faker"
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/tlscertificate/api"
+	"github.com/monitoror/monitoror/monitorables/tlscertificate/api/models"
+	"github.com/monitoror/monitoror/pkg/nonempty"
+)
+
+type (
+	tlsCertificateUsecase struct {
+		timeRefByHostnamePort map[string]time.Time
+	}
+)
+
+var availableStatuses = faker.Statuses{
+	{coreModels.SuccessStatus, time.Second * 30},
+	{coreModels.WarningStatus, time.Second * 15},
+	{coreModels.FailedStatus, time.Second * 15},
+}
+
+// daysByStatus are displayed when params.ValueValues is empty
+var daysByStatus = map[coreModels.TileStatus]string{
+	coreModels.SuccessStatus: "82",
+	coreModels.WarningStatus: "21",
+	coreModels.FailedStatus:  "3",
+}
+
+func NewTLSCertificateUsecase() api.Usecase {
+	return &tlsCertificateUsecase{make(map[string]time.Time)}
+}
+
+func (tu *tlsCertificateUsecase) TLSCertificate(params *models.TLSCertificateParams) (*coreModels.Tile, error) {
+	tile := coreModels.NewTile(api.TLSCertificateTileType)
+	tile.Label = fmt.Sprintf("%s:%d", params.Hostname, params.GetPort())
+
+	tile.Status = nonempty.Struct(params.Status, tu.computeStatus(tile.Label)).(coreModels.TileStatus)
+
+	tile.WithValue(coreModels.NumberUnit)
+	tile.Value.Values = params.ValueValues
+	if len(tile.Value.Values) == 0 {
+		tile.Value.Values = []string{daysByStatus[tile.Status]}
+	}
+	tile.Value.Suffix = " days"
+
+	return tile, nil
+}
+
+func (tu *tlsCertificateUsecase) TLSCertificateGenerator(params interface{}) ([]uiConfigModels.GeneratedTile, error) {
+	panic("unimplemented")
+}
+
+func (tu *tlsCertificateUsecase) computeStatus(key string) coreModels.TileStatus {
+	value, ok := tu.timeRefByHostnamePort[key]
+	if !ok {
+		tu.timeRefByHostnamePort[key] = faker.GetRefTime()
+	}
+
+	return faker.ComputeStatus(value, availableStatuses)
+}
@@ -0,0 +1,187 @@
+package usecase
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	uiConfigModels "github.com/monitoror/monitoror/api/config/models"
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/tlscertificate/api"
+	"github.com/monitoror/monitoror/monitorables/tlscertificate/api/mocks"
+	"github.com/monitoror/monitoror/monitorables/tlscertificate/api/models"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+)
+
+// generateCertificate return a self-signed certificate for monitoror.example.com expiring after given duration
+func generateCertificate(t *testing.T, validity time.Duration) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "monitoror.example.com"},
+		DNSNames:              []string{"monitoror.example.com"},
+		NotBefore:             time.Now().Add(-365 * 24 * time.Hour),
+		NotAfter:              time.Now().Add(validity),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	return certificate
+}
+
+func TestUsecase_TLSCertificate_Error(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockRepo.On("GetCertificates", "monitoror.example.com", 443, "monitoror.example.com", models.StartTLS("")).
+		Return(nil, errors.New("boom"))
+	usecase := NewTLSCertificateUsecase(mockRepo, nil)
+
+	tile, err := usecase.TLSCertificate(&models.TLSCertificateParams{Hostname: "monitoror.example.com"})
+	assert.Nil(t, tile)
+	if assert.Error(t, err) {
+		assert.IsType(t, &coreModels.MonitororError{}, err)
+		assert.Equal(t, "unable to get certificate of monitoror.example.com:443", err.Error())
+		mockRepo.AssertNumberOfCalls(t, "GetCertificates", 1)
+		mockRepo.AssertExpectations(t)
+	}
+}
+
+func TestUsecase_TLSCertificate(t *testing.T) {
+	valid := generateCertificate(t, 90*24*time.Hour+time.Hour)
+	soon := generateCertificate(t, 20*24*time.Hour+time.Hour)
+	verySoon := generateCertificate(t, 3*24*time.Hour+time.Hour)
+	expired := generateCertificate(t, -2*24*time.Hour+time.Hour)
+
+	roots := x509.NewCertPool()
+	for _, certificate := range []*x509.Certificate{valid, soon, verySoon, expired} {
+		roots.AddCert(certificate)
+	}
+
+	for _, testcase := range []struct {
+		certificate     *x509.Certificate
+		roots           *x509.CertPool
+		params          *models.TLSCertificateParams
+		expectedStatus  coreModels.TileStatus
+		expectedValue   string
+		expectedMessage string
+	}{
+		{
+			certificate:    valid,
+			roots:          roots,
+			params:         &models.TLSCertificateParams{Hostname: "monitoror.example.com"},
+			expectedStatus: coreModels.SuccessStatus,
+			expectedValue:  "90",
+		},
+		{
+			certificate:     soon,
+			roots:           roots,
+			params:          &models.TLSCertificateParams{Hostname: "monitoror.example.com"},
+			expectedStatus:  coreModels.WarningStatus,
+			expectedValue:   "20",
+			expectedMessage: "expires on " + soon.NotAfter.Format("2006-01-02"),
+		},
+		{
+			certificate:    soon,
+			roots:          roots,
+			params:         &models.TLSCertificateParams{Hostname: "monitoror.example.com", WarningDays: pointer.ToInt(10)},
+			expectedStatus: coreModels.SuccessStatus,
+			expectedValue:  "20",
+		},
+		{
+			certificate:     verySoon,
+			roots:           roots,
+			params:          &models.TLSCertificateParams{Hostname: "monitoror.example.com"},
+			expectedStatus:  coreModels.FailedStatus,
+			expectedValue:   "3",
+			expectedMessage: "expires on " + verySoon.NotAfter.Format("2006-01-02"),
+		},
+		{
+			certificate:     expired,
+			roots:           roots,
+			params:          &models.TLSCertificateParams{Hostname: "monitoror.example.com"},
+			expectedStatus:  coreModels.FailedStatus,
+			expectedValue:   "-2",
+			expectedMessage: "expired on " + expired.NotAfter.Format("2006-01-02"),
+		},
+		{
+			certificate:     valid,
+			roots:           x509.NewCertPool(),
+			params:          &models.TLSCertificateParams{Hostname: "monitoror.example.com"},
+			expectedStatus:  coreModels.FailedStatus,
+			expectedValue:   "90",
+			expectedMessage: "certificate signed by unknown authority",
+		},
+		{
+			certificate:     valid,
+			roots:           roots,
+			params:          &models.TLSCertificateParams{Hostname: "127.0.0.1", ServerName: "other.example.com"},
+			expectedStatus:  coreModels.FailedStatus,
+			expectedValue:   "90",
+			expectedMessage: "certificate is not valid for other.example.com",
+		},
+	} {
+		mockRepo := new(mocks.Repository)
+		mockRepo.On("GetCertificates", testcase.params.Hostname, 443, testcase.params.GetServerName(), models.StartTLS("")).
+			Return([]*x509.Certificate{testcase.certificate}, nil)
+		usecase := NewTLSCertificateUsecase(mockRepo, testcase.roots)
+
+		tile, err := usecase.TLSCertificate(testcase.params)
+		if assert.NoError(t, err) {
+			assert.Equal(t, api.TLSCertificateTileType, tile.Type)
+			assert.Equal(t, testcase.params.Hostname+":443", tile.Label)
+			assert.Equal(t, testcase.expectedStatus, tile.Status)
+			assert.Equal(t, []string{testcase.expectedValue}, tile.Value.Values)
+			assert.Equal(t, testcase.expectedMessage, tile.Message)
+			mockRepo.AssertNumberOfCalls(t, "GetCertificates", 1)
+			mockRepo.AssertExpectations(t)
+		}
+	}
+}
+
+func TestUsecase_TLSCertificateGenerator(t *testing.T) {
+	usecase := NewTLSCertificateUsecase(new(mocks.Repository), nil)
+
+	params := &models.TLSCertificateGeneratorParams{
+		Hosts:       []string{"monitoror.example.com", "mail.example.com:25"},
+		StartTLS:    models.SMTPStartTLS,
+		WarningDays: pointer.ToInt(15),
+	}
+
+	expected := []uiConfigModels.GeneratedTile{
+		{
+			Label:  "monitoror.example.com",
+			Params: &models.TLSCertificateParams{Hostname: "monitoror.example.com", StartTLS: models.SMTPStartTLS, WarningDays: pointer.ToInt(15)},
+		},
+		{
+			Label:  "mail.example.com:25",
+			Params: &models.TLSCertificateParams{Hostname: "mail.example.com", Port: 25, StartTLS: models.SMTPStartTLS, WarningDays: pointer.ToInt(15)},
+		},
+	}
+
+	tiles, err := usecase.TLSCertificateGenerator(params)
+	if assert.NoError(t, err) {
+		assert.Equal(t, expected, tiles)
+	}
+}
@@ -0,0 +1,30 @@
+package config
+
+import (
+	"crypto/x509"
+
+	"github.com/monitoror/monitoror/pkg/certpool"
+)
+
+type (
+	TLSCertificate struct {
+		Timeout int `validate:"gte=0"` // In Millisecond
+
+		// CACert is the path of a PEM bundle trusted in addition to system certificates
+		CACert string
+	}
+)
+
+var Default = &TLSCertificate{
+	Timeout: 5000,
+	CACert:  "",
+}
+
+// GetRootCAs load system certificates and CACert, pool is nil (system certificates) when CACert is empty
+func (c *TLSCertificate) GetRootCAs() (*x509.CertPool, error) {
+	if c.CACert == "" {
+		return nil, nil
+	}
+
+	return certpool.Load(c.CACert)
+}
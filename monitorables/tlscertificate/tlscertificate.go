@@ -0,0 +1,85 @@
+//+build !faker
+
+package tlscertificate
+
+import (
+	"fmt"
+
+	"github.com/monitoror/monitoror/api/config/versions"
+	pkgMonitorable "github.com/monitoror/monitoror/internal/pkg/monitorable"
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/tlscertificate/api"
+	tlsCertificateDelivery "github.com/monitoror/monitoror/monitorables/tlscertificate/api/delivery/http"
+	tlsCertificateModels "github.com/monitoror/monitoror/monitorables/tlscertificate/api/models"
+	tlsCertificateRepository "github.com/monitoror/monitoror/monitorables/tlscertificate/api/repository"
+	tlsCertificateUsecase "github.com/monitoror/monitoror/monitorables/tlscertificate/api/usecase"
+	tlsCertificateConfig "github.com/monitoror/monitoror/monitorables/tlscertificate/config"
+	"github.com/monitoror/monitoror/registry"
+	"github.com/monitoror/monitoror/store"
+)
+
+type Monitorable struct {
+	store *store.Store
+
+	config map[coreModels.VariantName]*tlsCertificateConfig.TLSCertificate
+
+	// Config tile settings
+	tlsCertificateTileEnabler      registry.TileEnabler
+	tlsCertificateGeneratorEnabler registry.GeneratorEnabler
+}
+
+func NewMonitorable(store *store.Store) *Monitorable {
+	m := &Monitorable{}
+	m.store = store
+	m.config = make(map[coreModels.VariantName]*tlsCertificateConfig.TLSCertificate)
+
+	// Load core config from env
+	pkgMonitorable.LoadConfig(&m.config, tlsCertificateConfig.Default)
+
+	// Register Monitorable Tile in config manager
+	m.tlsCertificateTileEnabler = store.Registry.RegisterTile(api.TLSCertificateTileType, versions.MinimalVersion, m.GetVariantsNames())
+	m.tlsCertificateGeneratorEnabler = store.Registry.RegisterGenerator(api.TLSCertificateTileType, versions.MinimalVersion, m.GetVariantsNames())
+
+	return m
+}
+
+func (m *Monitorable) GetDisplayName() string {
+	return "TLS Certificate"
+}
+
+func (m *Monitorable) GetVariantsNames() []coreModels.VariantName {
+	return pkgMonitorable.GetVariantsNames(m.config)
+}
+
+func (m *Monitorable) Validate(variantName coreModels.VariantName) (bool, []error) {
+	conf := m.config[variantName]
+
+	// Validate Config
+	if errors := pkgMonitorable.ValidateConfig(conf, variantName); errors != nil {
+		return false, errors
+	}
+
+	if _, err := conf.GetRootCAs(); err != nil {
+		return false, []error{fmt.Errorf("invalid CA bundle: %w", err)}
+	}
+
+	return true, nil
+}
+
+func (m *Monitorable) Enable(variantName coreModels.VariantName) {
+	conf := m.config[variantName]
+
+	roots, _ := conf.GetRootCAs() // Already validate by Monitorable.Validate
+
+	repository := tlsCertificateRepository.NewTLSCertificateRepository(conf)
+	usecase := tlsCertificateUsecase.NewTLSCertificateUsecase(repository, roots)
+	delivery := tlsCertificateDelivery.NewTLSCertificateDelivery(usecase)
+
+	// EnableTile route to echo
+	routeGroup := m.store.MonitorableRouter.Group("/tlscertificate", variantName)
+	route := routeGroup.GET("/certificate", delivery.GetTLSCertificate)
+
+	// EnableTile data for config hydration
+	m.tlsCertificateTileEnabler.Enable(variantName, &tlsCertificateModels.TLSCertificateParams{}, route.Path)
+	m.tlsCertificateGeneratorEnabler.Enable(variantName, &tlsCertificateModels.TLSCertificateGeneratorParams{}, usecase.TLSCertificateGenerator)
+}
@@ -0,0 +1,48 @@
+//+build faker
+
+package tlscertificate
+
+import (
+	"github.com/monitoror/monitoror/api/config/versions"
+	"github.com/monitoror/monitoror/internal/pkg/monitorable"
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/tlscertificate/api"
+	tlsCertificateDelivery "github.com/monitoror/monitoror/monitorables/tlscertificate/api/delivery/http"
+	tlsCertificateModels "github.com/monitoror/monitoror/monitorables/tlscertificate/api/models"
+	tlsCertificateUsecase "github.com/monitoror/monitoror/monitorables/tlscertificate/api/usecase"
+	"github.com/monitoror/monitoror/registry"
+	"github.com/monitoror/monitoror/store"
+)
+
+type Monitorable struct {
+	monitorable.DefaultMonitorableFaker
+
+	store *store.Store
+
+	// Config tile settings
+	tlsCertificateTileEnabler registry.TileEnabler
+}
+
+func NewMonitorable(store *store.Store) *Monitorable {
+	m := &Monitorable{}
+	m.store = store
+
+	// Register Monitorable Tile in config manager
+	m.tlsCertificateTileEnabler = store.Registry.RegisterTile(api.TLSCertificateTileType, versions.MinimalVersion, m.GetVariantsNames())
+
+	return m
+}
+
+func (m *Monitorable) GetDisplayName() string { return "TLS Certificate" }
+
+func (m *Monitorable) Enable(variantName coreModels.VariantName) {
+	usecase := tlsCertificateUsecase.NewTLSCertificateUsecase()
+	delivery := tlsCertificateDelivery.NewTLSCertificateDelivery(usecase)
+
+	// EnableTile route to echo
+	routeGroup := m.store.MonitorableRouter.Group("/tlscertificate", variantName)
+	route := routeGroup.GET("/certificate", delivery.GetTLSCertificate)
+
+	// EnableTile data for config hydration
+	m.tlsCertificateTileEnabler.Enable(variantName, &tlsCertificateModels.TLSCertificateParams{}, route.Path)
+}
@@ -0,0 +1,47 @@
+package tlscertificate
+
+import (
+	"os"
+	"testing"
+
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMonitorable(t *testing.T) {
+	// init Store
+	store, mockMonitorableHelper := test.InitMockAndStore()
+
+	// init Env
+	// Wrong Timeout
+	_ = os.Setenv("MO_MONITORABLE_TLSCERTIFICATE_VARIANT0_TIMEOUT", "-1000")
+	// Missing CA bundle
+	_ = os.Setenv("MO_MONITORABLE_TLSCERTIFICATE_VARIANT1_CACERT", "/missing/ca.pem")
+
+	// NewMonitorable
+	monitorable := NewMonitorable(store)
+	assert.NotNil(t, monitorable)
+
+	// GetDisplayName
+	assert.NotNil(t, monitorable.GetDisplayName())
+
+	// GetVariantsNames and check
+	if assert.Len(t, monitorable.GetVariantsNames(), 3) {
+		_, errors := monitorable.Validate("variant0")
+		assert.NotEmpty(t, errors)
+		_, errors = monitorable.Validate("variant1")
+		assert.NotEmpty(t, errors)
+	}
+
+	// Enable
+	for _, variantName := range monitorable.GetVariantsNames() {
+		if valid, _ := monitorable.Validate(variantName); valid {
+			monitorable.Enable(variantName)
+		}
+	}
+
+	// Test calls
+	mockMonitorableHelper.RouterAssertNumberOfCalls(t, 1, 1)
+	mockMonitorableHelper.TileSettingsManagerAssertNumberOfCalls(t, 1, 1, 1, 1)
+}
@@ -0,0 +1,65 @@
+package http
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/delivery"
+	"github.com/monitoror/monitoror/monitorables/webhook/api"
+	"github.com/monitoror/monitoror/monitorables/webhook/api/models"
+
+	"github.com/labstack/echo/v4"
+)
+
+const KeyPathParam = "key"
+
+type WebhookDelivery struct {
+	webhookUsecase api.Usecase
+
+	// secret expected in Authorization header of pushes
+	secret string
+}
+
+func NewWebhookDelivery(w api.Usecase, secret string) *WebhookDelivery {
+	return &WebhookDelivery{w, secret}
+}
+
+func (h *WebhookDelivery) GetWebhook(c echo.Context) error {
+	// Bind / check Params
+	params := &models.WebhookParams{}
+	if err := delivery.BindAndValidateParams(c, params); err != nil {
+		return err
+	}
+
+	tile, err := h.webhookUsecase.Webhook(params)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, tile)
+}
+
+func (h *WebhookDelivery) PostPush(c echo.Context) error {
+	authorization := c.Request().Header.Get(echo.HeaderAuthorization)
+	if subtle.ConstantTimeCompare([]byte(authorization), []byte(fmt.Sprintf("Bearer %s", h.secret))) != 1 {
+		return echo.ErrUnauthorized
+	}
+
+	key := c.Param(KeyPathParam)
+	if !models.KeyRegex.MatchString(key) {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid key, must match %s", models.KeyRegex.String()))
+	}
+
+	push := &models.Push{}
+	if err := json.NewDecoder(c.Request().Body).Decode(push); err != nil || !push.IsValid() {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid payload")
+	}
+
+	if err := h.webhookUsecase.Push(key, push); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,119 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/webhook/api"
+	"github.com/monitoror/monitoror/monitorables/webhook/api/mocks"
+	"github.com/monitoror/monitoror/monitorables/webhook/api/models"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	. "github.com/stretchr/testify/mock"
+)
+
+func initEcho() (ctx echo.Context, res *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(echo.GET, "/api/v1/webhook/default/webhook", nil)
+	res = httptest.NewRecorder()
+	ctx = e.NewContext(req, res)
+
+	ctx.QueryParams().Set("key", "deploy")
+
+	return
+}
+
+func initPushEcho(key, authorization, body string) (ctx echo.Context, res *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(echo.POST, "/api/v1/push/default/key", strings.NewReader(body))
+	req.Header.Set(echo.HeaderAuthorization, authorization)
+	res = httptest.NewRecorder()
+	ctx = e.NewContext(req, res)
+
+	ctx.SetParamNames(KeyPathParam)
+	ctx.SetParamValues(key)
+
+	return
+}
+
+func TestDelivery_GetWebhook_Success(t *testing.T) {
+	// Init
+	ctx, res := initEcho()
+
+	tile := coreModels.NewTile(api.WebhookTileType)
+	tile.Status = coreModels.SuccessStatus
+
+	mockUsecase := new(mocks.Usecase)
+	mockUsecase.On("Webhook", &models.WebhookParams{Key: "deploy"}).Return(tile, nil)
+	handler := NewWebhookDelivery(mockUsecase, "secret")
+
+	// Expected
+	json, err := json.Marshal(tile)
+	assert.NoError(t, err, "unable to marshal tile")
+
+	// Test
+	if assert.NoError(t, handler.GetWebhook(ctx)) {
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(json), strings.TrimSpace(res.Body.String()))
+		mockUsecase.AssertNumberOfCalls(t, "Webhook", 1)
+		mockUsecase.AssertExpectations(t)
+	}
+}
+
+func TestDelivery_GetWebhook_Error(t *testing.T) {
+	// Init
+	ctx, _ := initEcho()
+
+	mockUsecase := new(mocks.Usecase)
+	mockUsecase.On("Webhook", Anything).Return(nil, errors.New("webhook error"))
+	handler := NewWebhookDelivery(mockUsecase, "secret")
+
+	// Test
+	assert.Error(t, handler.GetWebhook(ctx))
+	mockUsecase.AssertNumberOfCalls(t, "Webhook", 1)
+}
+
+func TestDelivery_PostPush_Success(t *testing.T) {
+	// Init
+	ctx, res := initPushEcho("deploy", "Bearer secret", `{"status": "SUCCESS", "message": "v1.2.3"}`)
+
+	mockUsecase := new(mocks.Usecase)
+	mockUsecase.On("Push", "deploy", &models.Push{Status: coreModels.SuccessStatus, Message: "v1.2.3"}).Return(nil)
+	handler := NewWebhookDelivery(mockUsecase, "secret")
+
+	// Test
+	if assert.NoError(t, handler.PostPush(ctx)) {
+		assert.Equal(t, http.StatusNoContent, res.Code)
+		mockUsecase.AssertExpectations(t)
+	}
+}
+
+func TestDelivery_PostPush_Error(t *testing.T) {
+	for _, testcase := range []struct {
+		key, authorization, body string
+		expectedCode             int
+	}{
+		{"deploy", "", `{"status": "SUCCESS"}`, http.StatusUnauthorized},
+		{"deploy", "Bearer wrong", `{"status": "SUCCESS"}`, http.StatusUnauthorized},
+		{"deploy prod", "Bearer secret", `{"status": "SUCCESS"}`, http.StatusBadRequest},
+		{"deploy", "Bearer secret", `{"status": "OK"}`, http.StatusBadRequest},
+		{"deploy", "Bearer secret", `status=SUCCESS`, http.StatusBadRequest},
+	} {
+		ctx, _ := initPushEcho(testcase.key, testcase.authorization, testcase.body)
+
+		mockUsecase := new(mocks.Usecase)
+		handler := NewWebhookDelivery(mockUsecase, "secret")
+
+		err := handler.PostPush(ctx)
+		if assert.Error(t, err) && assert.IsType(t, &echo.HTTPError{}, err) {
+			assert.Equal(t, testcase.expectedCode, err.(*echo.HTTPError).Code)
+		}
+		mockUsecase.AssertNumberOfCalls(t, "Push", 0)
+	}
+}
@@ -0,0 +1,25 @@
+//+build !faker
+
+package models
+
+import (
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/params"
+	"github.com/monitoror/monitoror/internal/pkg/validator"
+)
+
+type (
+	WebhookParams struct {
+		params.Default
+
+		Key        string `json:"key" query:"key" validate:"required"`
+		StaleAfter *int   `json:"staleAfter,omitempty" query:"staleAfter" validate:"omitempty,gte=0"` // In Millisecond, override core configuration
+	}
+)
+
+func (p *WebhookParams) Validate() []validator.Error {
+	if p.Key != "" && !KeyRegex.MatchString(p.Key) {
+		return []validator.Error{validator.NewDefaultError("Key", KeyRegex.String())}
+	}
+
+	return nil
+}
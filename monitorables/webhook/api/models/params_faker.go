@@ -0,0 +1,20 @@
+//+build faker
+
+package models
+
+import (
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/params"
+	coreModels "github.com/monitoror/monitoror/models"
+)
+
+type (
+	WebhookParams struct {
+		params.Default
+
+		Key        string `json:"key" query:"key" validate:"required"`
+		StaleAfter *int   `json:"staleAfter,omitempty" query:"staleAfter"`
+
+		Status  coreModels.TileStatus `json:"status" query:"status"`
+		Message string                `json:"message" query:"message"`
+	}
+)
@@ -0,0 +1,23 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/test"
+
+	"github.com/AlekSi/pointer"
+)
+
+func TestWebhookParams_Validate(t *testing.T) {
+	param := &WebhookParams{}
+	test.AssertParams(t, param, 1)
+
+	param = &WebhookParams{Key: "deploy/prod"}
+	test.AssertParams(t, param, 1)
+
+	param = &WebhookParams{Key: "deploy-prod", StaleAfter: pointer.ToInt(-1)}
+	test.AssertParams(t, param, 1)
+
+	param = &WebhookParams{Key: "deploy-prod", StaleAfter: pointer.ToInt(60000)}
+	test.AssertParams(t, param, 0)
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"regexp"
+	"time"
+
+	coreModels "github.com/monitoror/monitoror/models"
+)
+
+type (
+	// Push is the payload POSTed by external systems, mirroring coreModels.Tile
+	Push struct {
+		Status  coreModels.TileStatus `json:"status"`
+		Message string                `json:"message,omitempty"`
+
+		Value *coreModels.TileValue `json:"value,omitempty"`
+		Build *coreModels.TileBuild `json:"build,omitempty"`
+
+		// ReceivedAt is set by monitoror when push is received
+		ReceivedAt time.Time `json:"receivedAt"`
+	}
+)
+
+// KeyRegex of webhook keys, used in push URL
+var KeyRegex = regexp.MustCompile(`^[a-zA-Z0-9_.-]{1,128}$`)
+
+// availableStatuses external systems can push
+var availableStatuses = map[coreModels.TileStatus]bool{
+	coreModels.SuccessStatus:        true,
+	coreModels.WarningStatus:        true,
+	coreModels.FailedStatus:         true,
+	coreModels.UnknownStatus:        true,
+	coreModels.DisabledStatus:       true,
+	coreModels.ActionRequiredStatus: true,
+	coreModels.CanceledStatus:       true,
+	coreModels.QueuedStatus:         true,
+	coreModels.RunningStatus:        true,
+}
+
+func (p *Push) IsValid() bool {
+	return availableStatuses[p.Status]
+}
@@ -0,0 +1,14 @@
+//go:generate mockery -name Repository
+
+package api
+
+import (
+	"github.com/monitoror/monitoror/monitorables/webhook/api/models"
+)
+
+type (
+	Repository interface {
+		GetPush(key string) (*models.Push, error)
+		SetPush(key string, push *models.Push) error
+	}
+)
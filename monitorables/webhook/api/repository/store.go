@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"fmt"
+
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/webhook/api"
+	"github.com/monitoror/monitoror/monitorables/webhook/api/models"
+
+	"github.com/jsdidierlaurent/echo-middleware/cache"
+)
+
+type (
+	webhookRepository struct {
+		store       cache.Store
+		variantName coreModels.VariantName
+	}
+)
+
+// WebhookStoreKeyPrefix prefix of pushes keys in store
+const WebhookStoreKeyPrefix = "monitoror.webhook.key"
+
+func NewWebhookRepository(store cache.Store, variantName coreModels.VariantName) api.Repository {
+	return &webhookRepository{store, variantName}
+}
+
+// GetPush return last push of key, nil if nothing was pushed yet
+func (r *webhookRepository) GetPush(key string) (*models.Push, error) {
+	push := models.Push{}
+	if err := r.store.Get(r.storeKey(key), &push); err != nil {
+		if err == cache.ErrCacheMiss {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &push, nil
+}
+
+func (r *webhookRepository) SetPush(key string, push *models.Push) error {
+	return r.store.Set(r.storeKey(key), *push, cache.NEVER)
+}
+
+func (r *webhookRepository) storeKey(key string) string {
+	return fmt.Sprintf("%s:%s:%s", WebhookStoreKeyPrefix, r.variantName, key)
+}
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/webhook/api/models"
+
+	"github.com/jsdidierlaurent/echo-middleware/cache"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookRepository(t *testing.T) {
+	store := cache.NewGoCacheStore(time.Minute, time.Minute)
+	repository := NewWebhookRepository(store, "default")
+	otherRepository := NewWebhookRepository(store, "other")
+
+	push, err := repository.GetPush("deploy")
+	assert.NoError(t, err)
+	assert.Nil(t, push)
+
+	expected := &models.Push{Status: coreModels.SuccessStatus, ReceivedAt: time.Now()}
+	assert.NoError(t, repository.SetPush("deploy", expected))
+
+	push, err = repository.GetPush("deploy")
+	if assert.NoError(t, err) {
+		assert.Equal(t, expected, push)
+	}
+
+	// Keys are isolated by variant
+	push, err = otherRepository.GetPush("deploy")
+	assert.NoError(t, err)
+	assert.Nil(t, push)
+}
@@ -0,0 +1,19 @@
+//go:generate mockery -name Usecase
+
+package api
+
+import (
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/webhook/api/models"
+)
+
+const (
+	WebhookTileType coreModels.TileType = "WEBHOOK"
+)
+
+type (
+	Usecase interface {
+		Webhook(params *models.WebhookParams) (*coreModels.Tile, error)
+		Push(key string, push *models.Push) error
+	}
+)
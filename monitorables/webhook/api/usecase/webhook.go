@@ -0,0 +1,64 @@
+//+build !faker
+
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/webhook/api"
+	"github.com/monitoror/monitoror/monitorables/webhook/api/models"
+)
+
+type (
+	webhookUsecase struct {
+		repository api.Repository
+
+		// staleAfter is the duration without push before replacing status by staleStatus
+		staleAfter  time.Duration
+		staleStatus coreModels.TileStatus
+	}
+)
+
+func NewWebhookUsecase(repository api.Repository, staleAfter int, staleStatus coreModels.TileStatus) api.Usecase {
+	return &webhookUsecase{repository, time.Duration(staleAfter) * time.Millisecond, staleStatus}
+}
+
+func (wu *webhookUsecase) Webhook(params *models.WebhookParams) (*coreModels.Tile, error) {
+	tile := coreModels.NewTile(api.WebhookTileType)
+	tile.Label = params.Key
+
+	push, err := wu.repository.GetPush(params.Key)
+	if err != nil {
+		return nil, &coreModels.MonitororError{Err: err, Tile: tile, Message: "unable to get last push"}
+	}
+
+	if push == nil {
+		tile.Status = coreModels.UnknownStatus
+		tile.Message = "no update received"
+		return tile, nil
+	}
+
+	tile.Status = push.Status
+	tile.Message = push.Message
+	tile.Value = push.Value
+	tile.Build = push.Build
+
+	staleAfter := wu.staleAfter
+	if params.StaleAfter != nil {
+		staleAfter = time.Duration(*params.StaleAfter) * time.Millisecond
+	}
+
+	if since := time.Since(push.ReceivedAt); staleAfter > 0 && since > staleAfter {
+		tile.Status = wu.staleStatus
+		tile.Message = fmt.Sprintf("no update for %s", since.Truncate(time.Second))
+	}
+
+	return tile, nil
+}
+
+func (wu *webhookUsecase) Push(key string, push *models.Push) error {
+	push.ReceivedAt = time.Now()
+	return wu.repository.SetPush(key, push)
+}
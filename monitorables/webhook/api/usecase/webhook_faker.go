@@ -0,0 +1,54 @@
+//+build faker
+
+package usecase
+
+import (
+	"time"
+
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/faker"
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/webhook/api"
+	"github.com/monitoror/monitoror/monitorables/webhook/api/models"
+	"github.com/monitoror/monitoror/pkg/nonempty"
+)
+
+type (
+	webhookUsecase struct {
+		timeRefByKey map[string]time.Time
+	}
+)
+
+var availableStatuses = faker.Statuses{
+	{coreModels.SuccessStatus, time.Second * 30},
+	{coreModels.FailedStatus, time.Second * 15},
+	{coreModels.WarningStatus, time.Second * 15},
+}
+
+func NewWebhookUsecase() api.Usecase {
+	return &webhookUsecase{make(map[string]time.Time)}
+}
+
+func (wu *webhookUsecase) Webhook(params *models.WebhookParams) (tile *coreModels.Tile, err error) {
+	tile = coreModels.NewTile(api.WebhookTileType)
+	tile.Label = params.Key
+
+	// Code
+	tile.Status = nonempty.Struct(params.Status, wu.computeStatus(params)).(coreModels.TileStatus)
+	tile.Message = params.Message
+
+	return
+}
+
+// Push is ignored with faker
+func (wu *webhookUsecase) Push(_ string, _ *models.Push) error {
+	return nil
+}
+
+func (wu *webhookUsecase) computeStatus(params *models.WebhookParams) coreModels.TileStatus {
+	value, ok := wu.timeRefByKey[params.Key]
+	if !ok {
+		wu.timeRefByKey[params.Key] = faker.GetRefTime()
+	}
+
+	return faker.ComputeStatus(value, availableStatuses)
+}
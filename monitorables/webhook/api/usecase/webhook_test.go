@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/webhook/api"
+	"github.com/monitoror/monitoror/monitorables/webhook/api/mocks"
+	"github.com/monitoror/monitoror/monitorables/webhook/api/models"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	. "github.com/stretchr/testify/mock"
+)
+
+func TestUsecase_Webhook(t *testing.T) {
+	value := &coreModels.TileValue{Unit: coreModels.NumberUnit, Values: []string{"42"}}
+
+	for _, testcase := range []struct {
+		push         *models.Push
+		staleAfter   *int
+		expectedTile *coreModels.Tile
+	}{
+		{
+			push:         nil,
+			expectedTile: &coreModels.Tile{Status: coreModels.UnknownStatus, Message: "no update received"},
+		},
+		{
+			push:         &models.Push{Status: coreModels.SuccessStatus, Message: "deployed", Value: value, ReceivedAt: time.Now()},
+			expectedTile: &coreModels.Tile{Status: coreModels.SuccessStatus, Message: "deployed", Value: value},
+		},
+		{
+			push:         &models.Push{Status: coreModels.SuccessStatus, Value: value, ReceivedAt: time.Now().Add(-2 * time.Hour)},
+			expectedTile: &coreModels.Tile{Status: coreModels.WarningStatus, Message: "no update for 2h0m0s", Value: value},
+		},
+		{
+			push:         &models.Push{Status: coreModels.FailedStatus, ReceivedAt: time.Now().Add(-2 * time.Hour)},
+			staleAfter:   pointer.ToInt(0),
+			expectedTile: &coreModels.Tile{Status: coreModels.FailedStatus},
+		},
+		{
+			push:         &models.Push{Status: coreModels.SuccessStatus, ReceivedAt: time.Now().Add(-time.Minute)},
+			staleAfter:   pointer.ToInt(1000),
+			expectedTile: &coreModels.Tile{Status: coreModels.WarningStatus, Message: "no update for 1m0s"},
+		},
+	} {
+		mockRepository := new(mocks.Repository)
+		mockRepository.On("GetPush", "deploy").Return(testcase.push, nil)
+		usecase := NewWebhookUsecase(mockRepository, 3600000, coreModels.WarningStatus)
+
+		tile, err := usecase.Webhook(&models.WebhookParams{Key: "deploy", StaleAfter: testcase.staleAfter})
+		if assert.NoError(t, err) {
+			testcase.expectedTile.Type = api.WebhookTileType
+			testcase.expectedTile.Label = "deploy"
+			assert.Equal(t, testcase.expectedTile, tile)
+			mockRepository.AssertExpectations(t)
+		}
+	}
+}
+
+func TestUsecase_Webhook_Error(t *testing.T) {
+	mockRepository := new(mocks.Repository)
+	mockRepository.On("GetPush", Anything).Return(nil, errors.New("boom"))
+	usecase := NewWebhookUsecase(mockRepository, 0, coreModels.WarningStatus)
+
+	tile, err := usecase.Webhook(&models.WebhookParams{Key: "deploy"})
+	if assert.Error(t, err) {
+		assert.Nil(t, tile)
+		assert.IsType(t, &coreModels.MonitororError{}, err)
+	}
+}
+
+func TestUsecase_Push(t *testing.T) {
+	mockRepository := new(mocks.Repository)
+	mockRepository.On("SetPush", "deploy", AnythingOfType("*models.Push")).Return(nil)
+	usecase := NewWebhookUsecase(mockRepository, 0, coreModels.WarningStatus)
+
+	push := &models.Push{Status: coreModels.SuccessStatus}
+	assert.NoError(t, usecase.Push("deploy", push))
+	assert.False(t, push.ReceivedAt.IsZero())
+	mockRepository.AssertExpectations(t)
+}
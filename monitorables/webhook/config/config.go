@@ -0,0 +1,19 @@
+package config
+
+import (
+	coreModels "github.com/monitoror/monitoror/models"
+)
+
+type (
+	Webhook struct {
+		Secret      string                // Shared secret, sent by external systems as Bearer token
+		StaleAfter  int                   `validate:"gte=0"` // In Millisecond, 0 to disable
+		StaleStatus coreModels.TileStatus `validate:"oneof=WARNING UNKNOWN"`
+	}
+)
+
+var Default = &Webhook{
+	Secret:      "",
+	StaleAfter:  3600000,
+	StaleStatus: coreModels.WarningStatus,
+}
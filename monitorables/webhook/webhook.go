@@ -0,0 +1,92 @@
+//+build !faker
+
+package webhook
+
+import (
+	"fmt"
+
+	"github.com/monitoror/monitoror/api/config/versions"
+	pkgMonitorable "github.com/monitoror/monitoror/internal/pkg/monitorable"
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/webhook/api"
+	webhookDelivery "github.com/monitoror/monitoror/monitorables/webhook/api/delivery/http"
+	webhookModels "github.com/monitoror/monitoror/monitorables/webhook/api/models"
+	webhookRepository "github.com/monitoror/monitoror/monitorables/webhook/api/repository"
+	webhookUsecase "github.com/monitoror/monitoror/monitorables/webhook/api/usecase"
+	webhookConfig "github.com/monitoror/monitoror/monitorables/webhook/config"
+	"github.com/monitoror/monitoror/registry"
+	"github.com/monitoror/monitoror/service/options"
+	"github.com/monitoror/monitoror/store"
+
+	"github.com/labstack/echo/v4/middleware"
+)
+
+// pushBodyLimit limit size of pushed payloads
+const pushBodyLimit = "64K"
+
+type Monitorable struct {
+	store *store.Store
+
+	config map[coreModels.VariantName]*webhookConfig.Webhook
+
+	// Config tile settings
+	webhookTileEnabler registry.TileEnabler
+}
+
+func NewMonitorable(store *store.Store) *Monitorable {
+	m := &Monitorable{}
+	m.store = store
+	m.config = make(map[coreModels.VariantName]*webhookConfig.Webhook)
+
+	// Load core config from env
+	pkgMonitorable.LoadConfig(&m.config, webhookConfig.Default)
+
+	// Register Monitorable Tile in config manager
+	m.webhookTileEnabler = store.Registry.RegisterTile(api.WebhookTileType, versions.MinimalVersion, m.GetVariantsNames())
+
+	return m
+}
+
+func (m *Monitorable) GetDisplayName() string {
+	return "Webhook"
+}
+
+func (m *Monitorable) GetVariantsNames() []coreModels.VariantName {
+	return pkgMonitorable.GetVariantsNames(m.config)
+}
+
+func (m *Monitorable) Validate(variantName coreModels.VariantName) (bool, []error) {
+	conf := m.config[variantName]
+
+	// No configuration set
+	if conf.Secret == "" {
+		return false, nil
+	}
+
+	// Validate Config
+	if errors := pkgMonitorable.ValidateConfig(conf, variantName); errors != nil {
+		return false, errors
+	}
+
+	return true, nil
+}
+
+func (m *Monitorable) Enable(variantName coreModels.VariantName) {
+	conf := m.config[variantName]
+
+	repository := webhookRepository.NewWebhookRepository(m.store.CacheStore, variantName)
+	usecase := webhookUsecase.NewWebhookUsecase(repository, conf.StaleAfter, conf.StaleStatus)
+	delivery := webhookDelivery.NewWebhookDelivery(usecase, conf.Secret)
+
+	// EnableTile route to echo, without cache to show pushes immediately
+	routeGroup := m.store.MonitorableRouter.Group("/webhook", variantName)
+	route := routeGroup.GET("/webhook", delivery.GetWebhook, options.WithNoCache())
+
+	// Push route used by external systems
+	pushRouteGroup := m.store.MonitorableRouter.Group("/push", variantName)
+	pushRouteGroup.POST(fmt.Sprintf("/:%s", webhookDelivery.KeyPathParam), delivery.PostPush,
+		options.WithMiddlewares(middleware.BodyLimit(pushBodyLimit)))
+
+	// EnableTile data for config hydration
+	m.webhookTileEnabler.Enable(variantName, &webhookModels.WebhookParams{}, route.Path)
+}
@@ -0,0 +1,55 @@
+//+build faker
+
+package webhook
+
+import (
+	"fmt"
+
+	"github.com/monitoror/monitoror/api/config/versions"
+	"github.com/monitoror/monitoror/internal/pkg/monitorable"
+	coreModels "github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/monitorables/webhook/api"
+	webhookDelivery "github.com/monitoror/monitoror/monitorables/webhook/api/delivery/http"
+	webhookModels "github.com/monitoror/monitoror/monitorables/webhook/api/models"
+	webhookUsecase "github.com/monitoror/monitoror/monitorables/webhook/api/usecase"
+	"github.com/monitoror/monitoror/registry"
+	"github.com/monitoror/monitoror/service/options"
+	"github.com/monitoror/monitoror/store"
+)
+
+type Monitorable struct {
+	monitorable.DefaultMonitorableFaker
+
+	store *store.Store
+
+	// Config tile settings
+	webhookTileEnabler registry.TileEnabler
+}
+
+func NewMonitorable(store *store.Store) *Monitorable {
+	m := &Monitorable{}
+	m.store = store
+
+	// Register Monitorable Tile in config manager
+	m.webhookTileEnabler = store.Registry.RegisterTile(api.WebhookTileType, versions.MinimalVersion, m.GetVariantsNames())
+
+	return m
+}
+
+func (m *Monitorable) GetDisplayName() string { return "Webhook" }
+
+func (m *Monitorable) Enable(variantName coreModels.VariantName) {
+	usecase := webhookUsecase.NewWebhookUsecase()
+	delivery := webhookDelivery.NewWebhookDelivery(usecase, "faker")
+
+	// EnableTile route to echo
+	routeGroup := m.store.MonitorableRouter.Group("/webhook", variantName)
+	route := routeGroup.GET("/webhook", delivery.GetWebhook, options.WithNoCache())
+
+	// Push route used by external systems
+	pushRouteGroup := m.store.MonitorableRouter.Group("/push", variantName)
+	pushRouteGroup.POST(fmt.Sprintf("/:%s", webhookDelivery.KeyPathParam), delivery.PostPush)
+
+	// EnableTile data for config hydration
+	m.webhookTileEnabler.Enable(variantName, &webhookModels.WebhookParams{}, route.Path)
+}
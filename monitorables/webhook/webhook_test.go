@@ -0,0 +1,50 @@
+package webhook
+
+import (
+	"os"
+	"testing"
+
+	"github.com/monitoror/monitoror/internal/pkg/monitorable/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMonitorable(t *testing.T) {
+	// init Store
+	store, mockMonitorableHelper := test.InitMockAndStore()
+
+	// init Env
+	_ = os.Setenv("MO_MONITORABLE_WEBHOOK_SECRET", "secret")
+	// Wrong StaleStatus
+	_ = os.Setenv("MO_MONITORABLE_WEBHOOK_VARIANT0_SECRET", "secret")
+	_ = os.Setenv("MO_MONITORABLE_WEBHOOK_VARIANT0_STALESTATUS", "SUCCESS")
+	// Not configured
+	_ = os.Setenv("MO_MONITORABLE_WEBHOOK_VARIANT1_STALEAFTER", "1000")
+
+	// NewMonitorable
+	monitorable := NewMonitorable(store)
+	assert.NotNil(t, monitorable)
+
+	// GetDisplayName
+	assert.NotNil(t, monitorable.GetDisplayName())
+
+	// GetVariantsNames and check
+	if assert.Len(t, monitorable.GetVariantsNames(), 3) {
+		_, errors := monitorable.Validate("variant0")
+		assert.NotEmpty(t, errors)
+		valid, errors := monitorable.Validate("variant1")
+		assert.False(t, valid)
+		assert.Empty(t, errors)
+	}
+
+	// Enable
+	for _, variantName := range monitorable.GetVariantsNames() {
+		if valid, _ := monitorable.Validate(variantName); valid {
+			monitorable.Enable(variantName)
+		}
+	}
+
+	// Test calls
+	mockMonitorableHelper.RouterAssertNumberOfCalls(t, 2, 1)
+	mockMonitorableHelper.TileSettingsManagerAssertNumberOfCalls(t, 1, 0, 1, 0)
+}
@@ -0,0 +1,25 @@
+package certpool
+
+import (
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+)
+
+// Load return system certificates with certificates of caCertPath PEM bundle
+func Load(caCertPath string) (*x509.CertPool, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+
+	pem, err := ioutil.ReadFile(caCertPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read CA bundle: %w", err)
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no valid certificate found in CA bundle: %s", caCertPath)
+	}
+
+	return pool, nil
+}
@@ -0,0 +1,43 @@
+package certpool
+
+import (
+	"crypto/tls"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoad(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	tmpDir, err := ioutil.TempDir("", "certpool")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	validPath := filepath.Join(tmpDir, "ca.pem")
+	assert.NoError(t, ioutil.WriteFile(validPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0600))
+	invalidPath := filepath.Join(tmpDir, "invalid.pem")
+	assert.NoError(t, ioutil.WriteFile(invalidPath, []byte("not a certificate"), 0600))
+
+	pool, err := Load(validPath)
+	if assert.NoError(t, err) {
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool}}}
+		resp, err := client.Get(server.URL)
+		if assert.NoError(t, err) {
+			_ = resp.Body.Close()
+		}
+	}
+
+	_, err = Load(invalidPath)
+	assert.EqualError(t, err, "no valid certificate found in CA bundle: "+invalidPath)
+
+	_, err = Load(filepath.Join(tmpDir, "missing.pem"))
+	assert.Error(t, err)
+}
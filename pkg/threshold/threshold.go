@@ -0,0 +1,51 @@
+package threshold
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type (
+	// Threshold compare value with a number. Written like ">10", "<= 0.5" or "!=0" ("=" is an alias of "==")
+	Threshold struct {
+		operator string
+		value    float64
+	}
+)
+
+var thresholdRegex = regexp.MustCompile(`^(>=|<=|==|!=|>|<|=)\s*(-?[0-9]+(?:\.[0-9]+)?)$`)
+
+// Parse threshold expression
+func Parse(expression string) (*Threshold, error) {
+	matches := thresholdRegex.FindStringSubmatch(strings.TrimSpace(expression))
+	if matches == nil {
+		return nil, fmt.Errorf(`invalid threshold %q, must be an operator (>, >=, <, <=, ==, =, !=) followed by a number`, expression)
+	}
+
+	value, _ := strconv.ParseFloat(matches[2], 64)
+	return &Threshold{operator: matches[1], value: value}, nil
+}
+
+// Match return true when value is matching threshold
+func (t *Threshold) Match(value float64) bool {
+	switch t.operator {
+	case ">":
+		return value > t.value
+	case ">=":
+		return value >= t.value
+	case "<":
+		return value < t.value
+	case "<=":
+		return value <= t.value
+	case "!=":
+		return value != t.value
+	default: // "==", "="
+		return value == t.value
+	}
+}
+
+func (t *Threshold) String() string {
+	return fmt.Sprintf("%s%s", t.operator, strconv.FormatFloat(t.value, 'f', -1, 64))
+}
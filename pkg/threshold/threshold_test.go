@@ -0,0 +1,54 @@
+package threshold
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	for _, testcase := range []struct {
+		expression string
+		expected   string
+	}{
+		{expression: ">10", expected: ">10"},
+		{expression: " >= 0.5 ", expected: ">=0.5"},
+		{expression: "<-2", expected: "<-2"},
+		{expression: "<=3", expected: "<=3"},
+		{expression: "==0", expected: "==0"},
+		{expression: "=0", expected: "=0"},
+		{expression: "!=1", expected: "!=1"},
+	} {
+		threshold, err := Parse(testcase.expression)
+		if assert.NoError(t, err, testcase.expression) {
+			assert.Equal(t, testcase.expected, threshold.String())
+		}
+	}
+}
+
+func TestParse_WithError(t *testing.T) {
+	for _, expression := range []string{"", "10", ">", "=>10", ">10a", "> ten"} {
+		_, err := Parse(expression)
+		assert.Error(t, err, expression)
+	}
+}
+
+func TestThreshold_Match(t *testing.T) {
+	for _, testcase := range []struct {
+		expression string
+		value      float64
+		expected   bool
+	}{
+		{expression: ">10", value: 11, expected: true},
+		{expression: ">10", value: 10, expected: false},
+		{expression: ">=10", value: 10, expected: true},
+		{expression: "<0.5", value: 0.4, expected: true},
+		{expression: "<=0.5", value: 0.6, expected: false},
+		{expression: "==0", value: 0, expected: true},
+		{expression: "=0", value: 1, expected: false},
+		{expression: "!=0", value: 1, expected: true},
+	} {
+		threshold, _ := Parse(testcase.expression)
+		assert.Equal(t, testcase.expected, threshold.Match(testcase.value), testcase.expression)
+	}
+}
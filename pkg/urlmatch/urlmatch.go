@@ -0,0 +1,45 @@
+package urlmatch
+
+import (
+	"net"
+	"net/url"
+	"path"
+	"strings"
+)
+
+var defaultPorts = map[string]string{
+	"http":  "80",
+	"https": "443",
+}
+
+// HasPrefix return true when rawURL starts with prefix on scheme, host and path boundaries
+// ex: "https://example.com/a" match "https://example.com/a/b" but neither "https://example.com/ab" nor "https://example.com.evil.net/a"
+// prefix without scheme or host never match
+func HasPrefix(rawURL, prefix string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	p, err := url.Parse(prefix)
+	if err != nil || p.Scheme == "" || p.Host == "" {
+		return false
+	}
+
+	if !strings.EqualFold(u.Scheme, p.Scheme) || hostWithPort(u) != hostWithPort(p) {
+		return false
+	}
+
+	// Cleaned paths, to avoid "/prefix/../other" matching "/prefix"
+	prefixPath := strings.TrimSuffix(path.Clean("/"+p.Path), "/")
+	urlPath := path.Clean("/" + u.Path)
+
+	return prefixPath == "" || urlPath == prefixPath || strings.HasPrefix(urlPath, prefixPath+"/")
+}
+
+func hostWithPort(u *url.URL) string {
+	port := u.Port()
+	if port == "" {
+		port = defaultPorts[strings.ToLower(u.Scheme)]
+	}
+	return net.JoinHostPort(strings.ToLower(u.Hostname()), port)
+}
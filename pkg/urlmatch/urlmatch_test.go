@@ -0,0 +1,35 @@
+package urlmatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasPrefix(t *testing.T) {
+	for _, testcase := range []struct {
+		url      string
+		prefix   string
+		expected bool
+	}{
+		{url: "https://conf.example.com/screen.json", prefix: "https://conf.example.com", expected: true},
+		{url: "https://conf.example.com/screen.json", prefix: "https://conf.example.com/", expected: true},
+		{url: "https://CONF.example.com:443/screen.json", prefix: "HTTPS://conf.example.com", expected: true},
+		{url: "https://conf.example.com/team/screen.json", prefix: "https://conf.example.com/team", expected: true},
+		{url: "https://conf.example.com/team/screen.json", prefix: "https://conf.example.com/team/", expected: true},
+		{url: "https://conf.example.com/team", prefix: "https://conf.example.com/team/", expected: true},
+		{url: "http://localhost:8080/a?b=c", prefix: "http://localhost:8080", expected: true},
+
+		{url: "https://conf.example.com.evil.net/screen.json", prefix: "https://conf.example.com"},
+		{url: "https://conf.example.com@evil.net/screen.json", prefix: "https://conf.example.com"},
+		{url: "http://conf.example.com/screen.json", prefix: "https://conf.example.com"},
+		{url: "https://conf.example.com:8443/screen.json", prefix: "https://conf.example.com"},
+		{url: "https://conf.example.com/teams/screen.json", prefix: "https://conf.example.com/team"},
+		{url: "https://conf.example.com/team/../other/screen.json", prefix: "https://conf.example.com/team"},
+		{url: "https://conf.example.com/screen.json", prefix: ""},
+		{url: "https://conf.example.com/screen.json", prefix: "conf.example.com"},
+		{url: "://invalid", prefix: "https://conf.example.com"},
+	} {
+		assert.Equal(t, testcase.expected, HasPrefix(testcase.url, testcase.prefix), "%s / %s", testcase.url, testcase.prefix)
+	}
+}
@@ -32,6 +32,20 @@ func (_m *Registry) GetMonitorables() []*registry.MonitorableMetadata {
 	return r0
 }
 
+// IsTileRegistered provides a mock function with given fields: tileType
+func (_m *Registry) IsTileRegistered(tileType models.TileType) bool {
+	ret := _m.Called(tileType)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(models.TileType) bool); ok {
+		r0 = rf(tileType)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // RegisterGenerator provides a mock function with given fields: generatedTileType, minimalVersion, variantNames
 func (_m *Registry) RegisterGenerator(generatedTileType models.TileType, minimalVersion versions.RawVersion, variantNames []models.VariantName) registry.GeneratorEnabler {
 	ret := _m.Called(generatedTileType, minimalVersion, variantNames)
@@ -4,6 +4,7 @@ package registry
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/monitoror/monitoror/api/config/models"
 	"github.com/monitoror/monitoror/api/config/versions"
@@ -18,6 +19,7 @@ type (
 		RegisterTile(tileType coreModels.TileType, minimalVersion versions.RawVersion, variantNames []coreModels.VariantName) TileEnabler
 		RegisterGenerator(generatedTileType coreModels.TileType, minimalVersion versions.RawVersion, variantNames []coreModels.VariantName) GeneratorEnabler
 		GetMonitorables() []*MonitorableMetadata
+		IsTileRegistered(tileType coreModels.TileType) bool
 	}
 	// TileEnabler is returned to monitorable after register to enable monitorable tile with this variant if she is "valid"
 	TileEnabler interface {
@@ -46,6 +48,9 @@ type (
 		MonitorableMetadata []*MonitorableMetadata
 		TileMetadata        map[coreModels.TileType]*tileMetadata
 		GeneratorMetadata   map[coreModels.TileType]*generatorMetadata
+
+		// mutex protect TileMetadata, tiles can be registered after startup (see plugin monitorable)
+		mutex sync.RWMutex
 	}
 
 	MonitorableMetadata struct {
@@ -67,6 +72,9 @@ type (
 		MinimalVersion versions.RawVersion
 		// VariantsMetadata list all registered variants (can be available or not)
 		VariantsMetadata map[coreModels.VariantName]*tileVariantMetadata
+
+		// mutex of the registry
+		mutex *sync.RWMutex
 	}
 
 	tileVariantMetadata struct {
@@ -135,23 +143,32 @@ func (r *MetadataRegistry) RegisterMonitorable(monitorable coreModels.Monitorabl
 	r.MonitorableMetadata = append(r.MonitorableMetadata, monitorableMetadata)
 }
 
+// RegisterTile register tile type with given variants. Variants are added if tile type is already registered
 func (r *MetadataRegistry) RegisterTile(tileType coreModels.TileType, minimalVersion versions.RawVersion, variantNames []coreModels.VariantName) TileEnabler {
-	tileMetadata := &tileMetadata{
-		TileType:         tileType,
-		MinimalVersion:   minimalVersion,
-		VariantsMetadata: make(map[coreModels.VariantName]*tileVariantMetadata),
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	metadata, exists := r.TileMetadata[tileType]
+	if !exists {
+		metadata = &tileMetadata{
+			TileType:         tileType,
+			MinimalVersion:   minimalVersion,
+			VariantsMetadata: make(map[coreModels.VariantName]*tileVariantMetadata),
+			mutex:            &r.mutex,
+		}
+		r.TileMetadata[tileType] = metadata
 	}
 
 	// Register Variant with Enabled False
 	for _, variantName := range variantNames {
-		tileMetadata.VariantsMetadata[variantName] = &tileVariantMetadata{
-			VariantName: variantName,
+		if _, exists := metadata.VariantsMetadata[variantName]; !exists {
+			metadata.VariantsMetadata[variantName] = &tileVariantMetadata{
+				VariantName: variantName,
+			}
 		}
 	}
 
-	r.TileMetadata[tileType] = tileMetadata
-
-	return tileMetadata
+	return metadata
 }
 
 func (r *MetadataRegistry) RegisterGenerator(generatedTileType coreModels.TileType, minimalVersion versions.RawVersion, variantNames []coreModels.VariantName) GeneratorEnabler {
@@ -181,19 +198,59 @@ func (r *MetadataRegistry) GetMonitorables() []*MonitorableMetadata {
 	return r.MonitorableMetadata
 }
 
+func (r *MetadataRegistry) IsTileRegistered(tileType coreModels.TileType) bool {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	_, exists := r.TileMetadata[tileType]
+	return exists
+}
+
+// GetTileMetadata return a snapshot of registered tiles, used by verify
+func (r *MetadataRegistry) GetTileMetadata() map[coreModels.TileType]TileMetadataExplorer {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	result := make(map[coreModels.TileType]TileMetadataExplorer, len(r.TileMetadata))
+	for tileType, tileMetadata := range r.TileMetadata {
+		result[tileType] = tileMetadata
+	}
+	return result
+}
+
+// GetTileVariantMetadata return metadata of registered tile variant, used by hydrate
+func (r *MetadataRegistry) GetTileVariantMetadata(tileType coreModels.TileType, variantName coreModels.VariantName) (*tileVariantMetadata, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	tileMetadata, exists := r.TileMetadata[tileType]
+	if !exists {
+		return nil, false
+	}
+
+	variantMetadata, exists := tileMetadata.VariantsMetadata[variantName]
+	return variantMetadata, exists
+}
+
 // ----------------------------------------
 
 // TILE METADATA
 // ----------------------------------------
 func (tm *tileMetadata) Enable(variantName coreModels.VariantName, paramsValidator params.Validator, routePath string) {
-	variantMetadata, exists := tm.VariantsMetadata[variantName]
-	if !exists {
+	tm.mutex.Lock()
+	defer tm.mutex.Unlock()
+
+	if _, exists := tm.VariantsMetadata[variantName]; !exists {
 		panic(fmt.Sprintf("unable to enable unknown variantName: %s for tile: %s. register it before.", variantName, tm.TileType))
 	}
 
-	variantMetadata.Enabled = true
-	variantMetadata.ParamsValidator = paramsValidator
-	variantMetadata.RoutePath = &routePath
+	// Replace variant metadata instead of updating it, explorers returned before stay unchanged
+	tm.VariantsMetadata[variantName] = &tileVariantMetadata{
+		Enabled:         true,
+		VariantName:     variantName,
+		RoutePath:       &routePath,
+		ParamsValidator: paramsValidator,
+	}
 }
 
 func (tm *tileMetadata) GetMinimalVersion() versions.RawVersion {
@@ -201,11 +258,17 @@ func (tm *tileMetadata) GetMinimalVersion() versions.RawVersion {
 }
 
 func (tm *tileMetadata) GetVariant(variantName coreModels.VariantName) (VariantMetadataExplorer, bool) {
+	tm.mutex.RLock()
+	defer tm.mutex.RUnlock()
+
 	v, exists := tm.VariantsMetadata[variantName]
 	return v, exists
 }
 
 func (tm *tileMetadata) GetVariantsNames() []coreModels.VariantName {
+	tm.mutex.RLock()
+	defer tm.mutex.RUnlock()
+
 	var result []coreModels.VariantName
 	for variantName := range tm.VariantsMetadata {
 		result = append(result, variantName)
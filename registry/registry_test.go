@@ -42,6 +42,27 @@ func TestRegistry_Tiles(t *testing.T) {
 	}
 }
 
+func TestRegistry_RegisterTile_AddVariants(t *testing.T) {
+	registry := NewRegistry()
+	registry.RegisterTile("TEST", versions.CurrentVersion, []coreModels.VariantName{"variant1"}).
+		Enable("variant1", nil, "route1")
+	registry.RegisterTile("TEST", versions.CurrentVersion, []coreModels.VariantName{"variant2"}).
+		Enable("variant2", nil, "route2")
+
+	assert.True(t, registry.IsTileRegistered("TEST"))
+	assert.Len(t, registry.GetTileMetadata(), 1)
+	for variantName, routePath := range map[coreModels.VariantName]string{"variant1": "route1", "variant2": "route2"} {
+		variant, exists := registry.GetTileVariantMetadata("TEST", variantName)
+		if assert.True(t, exists) {
+			assert.True(t, variant.IsEnabled())
+			assert.Equal(t, routePath, *variant.RoutePath)
+		}
+	}
+
+	_, exists := registry.GetTileVariantMetadata("UNKNOWN", "variant1")
+	assert.False(t, exists)
+}
+
 func TestEnabler_Enable_Panic(t *testing.T) {
 	registry := NewRegistry()
 	tileEnabler := registry.RegisterTile("TEST", versions.CurrentVersion, []coreModels.VariantName{"test-variant"})
@@ -1,33 +1,75 @@
 package service
 
 import (
+	"fmt"
+
+	"github.com/monitoror/monitoror/api/config"
 	configDelivery "github.com/monitoror/monitoror/api/config/delivery/http"
+	configModels "github.com/monitoror/monitoror/api/config/models"
 	configRepository "github.com/monitoror/monitoror/api/config/repository"
 	configUsecase "github.com/monitoror/monitoror/api/config/usecase"
+	"github.com/monitoror/monitoror/api/config/versions"
+	"github.com/monitoror/monitoror/api/group"
 	"github.com/monitoror/monitoror/api/info"
+	"github.com/monitoror/monitoror/internal/pkg/path"
+	coreModels "github.com/monitoror/monitoror/models"
 	"github.com/monitoror/monitoror/monitorables"
+	"github.com/monitoror/monitoror/service/middlewares"
 	"github.com/monitoror/monitoror/service/router"
 
 	"github.com/jsdidierlaurent/echo-middleware/cache"
+	echoMiddleware "github.com/labstack/echo/v4/middleware"
 )
 
+const apiPrefix = "/api/v1"
+
 func InitApis(s *Server) {
 	// API group
-	apiGroup := s.Group("/api/v1")
+	apiGroup := s.Group(apiPrefix)
 
 	// ------------- INFO ------------- //
 	infoDelivery := info.NewHTTPInfoDelivery()
 	apiGroup.GET("/info", s.CacheMiddleware.UpstreamCacheHandlerWithExpiration(cache.NEVER, infoDelivery.GetInfo))
 
 	// ------------- CONFIG ------------- //
-	confRepository := configRepository.NewConfigRepository()
-	confUsecase := configUsecase.NewConfigUsecase(confRepository, s.store)
+	confRepository := configRepository.NewConfigRepository(s.store.CoreConfig.RemoteConfigs)
+	var confStoreRepository config.StoreRepository
+	if s.store.CoreConfig.ConfigStoreDir != "" {
+		confStoreRepository = configRepository.NewConfigStoreRepository(path.ToAbsolute(path.MonitororBaseDir, s.store.CoreConfig.ConfigStoreDir))
+	}
+	confUsecase := configUsecase.NewConfigUsecase(confRepository, confStoreRepository, s.store)
 	confDelivery := configDelivery.NewConfigDelivery(confUsecase)
+	configBodyLimit := echoMiddleware.BodyLimit(fmt.Sprintf("%dK", s.store.CoreConfig.ConfigMaxSize))
+	getConfigHandler := s.CacheMiddleware.UpstreamCacheHandler(confDelivery.GetConfig)
+	if confStoreRepository != nil {
+		// Stored configs change through the API, dashboards must get them right after a write
+		getConfigHandler = confDelivery.GetConfig
+	}
 	apiGroup.GET("/configs", s.CacheMiddleware.UpstreamCacheHandler(confDelivery.GetConfigList))
-	apiGroup.GET("/configs/:config", s.CacheMiddleware.UpstreamCacheHandler(confDelivery.GetConfig))
+	apiGroup.GET("/configs/:config", getConfigHandler)
+	apiGroup.POST("/configs/verify", confDelivery.VerifyConfig, configBodyLimit)
+	if confStoreRepository != nil {
+		apiGroup.GET("/configs/:config/revisions", confDelivery.GetConfigRevisions)
+
+		// Writes are only enabled with tokens identifying their authors
+		if tokens := s.store.CoreConfig.GetConfigStoreTokens(); len(tokens) > 0 {
+			writeAuth := configDelivery.WriteAuthMiddleware(tokens)
+			apiGroup.PUT("/configs/:config", confDelivery.SaveConfig, writeAuth, configBodyLimit)
+			apiGroup.DELETE("/configs/:config", confDelivery.DeleteConfig, writeAuth)
+			apiGroup.POST("/configs/:config/revisions/:revision/rollback", confDelivery.RollbackConfig, writeAuth)
+		}
+	}
+
+	// ------------- GROUP / SUMMARY ------------- //
+	groupDelivery := group.NewHTTPGroupDelivery(s.Echo, apiPrefix)
+	apiGroup.GET(configUsecase.GroupStatusRoutePath, groupDelivery.GetGroupStatus)
+	// Summary value is a number, history and thresholds are evaluated like on monitorable routes
+	apiGroup.GET(configUsecase.SummaryRoutePath, middlewares.ThresholdHandler(s.HistoryMiddleware.HistoryHandler(groupDelivery.GetSummary)))
+	s.store.Registry.RegisterTile(configUsecase.SummaryTileType, versions.MinimalVersion, []coreModels.VariantName{coreModels.DefaultVariantName}).
+		Enable(coreModels.DefaultVariantName, &configModels.SummaryParams{}, configUsecase.SummaryRoutePath)
 
 	// ---------------------------------- //
-	s.store.MonitorableRouter = router.NewMonitorableRouter(apiGroup, s.CacheMiddleware)
+	s.store.MonitorableRouter = router.NewMonitorableRouter(apiGroup, s.CacheMiddleware, s.HistoryMiddleware)
 	// ---------------------------------- //
 
 	// ------------- MONITORABLES ------------- //
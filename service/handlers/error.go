@@ -23,11 +23,11 @@ func HTTPErrorHandler(err error, ctx echo.Context) {
 		err = handleMonitororError(e, ctx)
 	default:
 		if he, ok := err.(*echo.HTTPError); ok {
-			if he.Code == http.StatusNotFound {
-				// 404
+			if he.Code < http.StatusInternalServerError {
+				// 4xx (ex: 404 Not Found, 413 Request Entity Too Large)
 				_ = ctx.JSON(he.Code, APIError{
 					Code:    he.Code,
-					Message: "Not Found",
+					Message: http.StatusText(he.Code),
 				})
 				return
 			}
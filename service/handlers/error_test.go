@@ -50,6 +50,28 @@ func TestHTTPError_404(t *testing.T) {
 	assert.Equal(t, string(j), strings.TrimSpace(res.Body.String()))
 }
 
+func TestHTTPError_413(t *testing.T) {
+	// Init
+	ctx, res := initErrorEcho()
+
+	// Parameters
+	err := echo.ErrStatusRequestEntityTooLarge
+
+	// Expected
+	apiError := APIError{
+		Code:    http.StatusRequestEntityTooLarge,
+		Message: "Request Entity Too Large",
+	}
+	j, e := json.Marshal(apiError)
+	assert.NoError(t, e, "unable to marshal tile")
+
+	// Test
+	HTTPErrorHandler(err, ctx)
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, res.Code)
+	assert.Equal(t, string(j), strings.TrimSpace(res.Body.String()))
+}
+
 func TestHTTPError_500(t *testing.T) {
 	// Init
 	ctx, res := initErrorEcho()
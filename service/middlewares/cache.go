@@ -1,6 +1,7 @@
 package middlewares
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/monitoror/monitoror/models"
@@ -28,6 +29,10 @@ type (
 		store                       cache.Store
 		downstreamDefaultExpiration time.Duration
 		upstreamDefaultExpiration   time.Duration
+
+		// Bounds of upstream expiration requested by tile (see models.CacheExpirationQueryParam)
+		minTileExpiration time.Duration
+		maxTileExpiration time.Duration
 	}
 
 	// Wrapper for setting value in store with 2 keys for timeout
@@ -38,8 +43,8 @@ type (
 )
 
 // NewCacheMiddleware used config to instantiate CacheMiddleware
-func NewCacheMiddleware(store cache.Store, downstreamDefaultExpiration, upstreamDefaultExpiration time.Duration) *CacheMiddleware {
-	return &CacheMiddleware{store, downstreamDefaultExpiration, upstreamDefaultExpiration}
+func NewCacheMiddleware(store cache.Store, downstreamDefaultExpiration, upstreamDefaultExpiration, minTileExpiration, maxTileExpiration time.Duration) *CacheMiddleware {
+	return &CacheMiddleware{store, downstreamDefaultExpiration, upstreamDefaultExpiration, minTileExpiration, maxTileExpiration}
 }
 
 //==============================================================================
@@ -48,20 +53,51 @@ func NewCacheMiddleware(store cache.Store, downstreamDefaultExpiration, upstream
 
 //UpstreamCacheHandler return the cached response if he finds it in the store. (Decorator Handlers)
 func (cm *CacheMiddleware) UpstreamCacheHandler(handle echo.HandlerFunc) echo.HandlerFunc {
-	return cache.CacheHandlerWithConfig(cache.CacheMiddlewareConfig{
-		Store:     &upstreamStore{cm.store, cm.downstreamDefaultExpiration},
-		KeyPrefix: "-", // Hack we need to replace this by real key prefix in Store definition
-		Expire:    cm.upstreamDefaultExpiration,
-	}, handle)
+	return cm.upstreamCacheHandler(cm.upstreamDefaultExpiration, 0, handle)
 }
 
 //UpstreamCacheHandlerWithExpiration return the cached response if he finds it in the store. (Decorator Handlers)
+// Expiration requested by tile can't be lower than expire
 func (cm *CacheMiddleware) UpstreamCacheHandlerWithExpiration(expire time.Duration, handle echo.HandlerFunc) echo.HandlerFunc {
-	return cache.CacheHandlerWithConfig(cache.CacheMiddlewareConfig{
+	return cm.upstreamCacheHandler(expire, expire, handle)
+}
+
+func (cm *CacheMiddleware) upstreamCacheHandler(expire, minExpire time.Duration, handle echo.HandlerFunc) echo.HandlerFunc {
+	defaultHandler := cache.CacheHandlerWithConfig(cm.upstreamCacheConfig(expire), handle)
+
+	// Never expiring cache can't be overridden by tile
+	if expire == cache.NEVER {
+		return defaultHandler
+	}
+
+	return func(ctx echo.Context) error {
+		tileExpire, err := strconv.Atoi(ctx.QueryParam(models.CacheExpirationQueryParam))
+		if err != nil || tileExpire <= 0 {
+			return defaultHandler(ctx)
+		}
+
+		// Bound expiration requested by tile
+		expire := time.Duration(tileExpire) * time.Millisecond
+		if cm.minTileExpiration > 0 && expire < cm.minTileExpiration {
+			expire = cm.minTileExpiration
+		}
+		if cm.maxTileExpiration > 0 && expire > cm.maxTileExpiration {
+			expire = cm.maxTileExpiration
+		}
+		if expire < minExpire {
+			expire = minExpire
+		}
+
+		return cache.CacheHandlerWithConfig(cm.upstreamCacheConfig(expire), handle)(ctx)
+	}
+}
+
+func (cm *CacheMiddleware) upstreamCacheConfig(expire time.Duration) cache.CacheMiddlewareConfig {
+	return cache.CacheMiddlewareConfig{
 		Store:     &upstreamStore{cm.store, cm.downstreamDefaultExpiration},
 		KeyPrefix: "-", // Hack we need to replace this by real key prefix in Store definition
 		Expire:    expire,
-	}, handle)
+	}
 }
 
 //==============================================================================
@@ -31,7 +31,7 @@ func TestCacheMiddleware(t *testing.T) {
 	e.HTTPErrorHandler = handlers.HTTPErrorHandler
 
 	store := cache.NewGoCacheStore(time.Minute*5, time.Millisecond*20)
-	cacheMiddleware := NewCacheMiddleware(store, time.Second, time.Millisecond*20)
+	cacheMiddleware := NewCacheMiddleware(store, time.Second, time.Millisecond*20, time.Millisecond*20, time.Minute)
 	e.Use(cacheMiddleware.DownstreamStoreMiddleware())
 
 	e.GET("/test", cacheMiddleware.UpstreamCacheHandler(func(c echo.Context) error {
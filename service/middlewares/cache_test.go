@@ -1,6 +1,9 @@
 package middlewares
 
 import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -13,7 +16,7 @@ import (
 
 func TestNewCacheMiddleware(t *testing.T) {
 	store := cache.NewGoCacheStore(time.Second, time.Second)
-	middleware := NewCacheMiddleware(store, time.Second, time.Second)
+	middleware := NewCacheMiddleware(store, time.Second, time.Second, time.Second, time.Minute)
 
 	if assert.NotNil(t, middleware) {
 		assert.NotNil(t, middleware.store)
@@ -38,6 +41,48 @@ func TestUpstreamCacheHandlerWithExpiration(t *testing.T) {
 	assert.NotNil(t, handle)
 }
 
+func TestUpstreamCacheHandler_WithTileExpiration(t *testing.T) {
+	for _, testcase := range []struct {
+		routeExpiration    *time.Duration
+		query              string
+		expectedExpiration time.Duration
+	}{
+		{query: "", expectedExpiration: time.Second * 10},
+		{query: "?cacheExpiration=test", expectedExpiration: time.Second * 10},
+		{query: "?cacheExpiration=300000", expectedExpiration: time.Minute * 5},
+		{query: "?cacheExpiration=1", expectedExpiration: time.Second},
+		{query: "?cacheExpiration=86400000", expectedExpiration: time.Hour},
+		{routeExpiration: pointerDuration(time.Minute * 10), query: "?cacheExpiration=300000", expectedExpiration: time.Minute * 10},
+		{routeExpiration: pointerDuration(time.Minute * 10), query: "?cacheExpiration=1800000", expectedExpiration: time.Minute * 30},
+	} {
+		mockStore := new(mocks.Store)
+		mockStore.On("Get", AnythingOfType("string"), Anything).Return(errors.New("not found"))
+		mockStore.On("Set", AnythingOfType("string"), Anything, AnythingOfType("time.Duration")).Return(nil)
+
+		middleware := NewCacheMiddleware(mockStore, time.Hour*2, time.Second*10, time.Second, time.Hour)
+		handlerFunc := func(c echo.Context) error { return c.String(http.StatusOK, "test") }
+
+		var handler echo.HandlerFunc
+		if testcase.routeExpiration != nil {
+			handler = middleware.UpstreamCacheHandlerWithExpiration(*testcase.routeExpiration, handlerFunc)
+		} else {
+			handler = middleware.UpstreamCacheHandler(handlerFunc)
+		}
+
+		req := httptest.NewRequest(echo.GET, "/test"+testcase.query, nil)
+		ctx := echo.New().NewContext(req, httptest.NewRecorder())
+		if assert.NoError(t, handler(ctx)) {
+			// Upstream then downstream
+			mockStore.AssertCalled(t, "Set", AnythingOfType("string"), Anything, testcase.expectedExpiration)
+			mockStore.AssertCalled(t, "Set", AnythingOfType("string"), Anything, time.Hour*2)
+		}
+	}
+}
+
+func pointerDuration(duration time.Duration) *time.Duration {
+	return &duration
+}
+
 func TestDownstreamStoreMiddleware(t *testing.T) {
 	middleware := &CacheMiddleware{store: &upstreamStore{}}
 	handle := middleware.DownstreamStoreMiddleware()
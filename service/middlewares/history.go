@@ -0,0 +1,109 @@
+package middlewares
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/monitoror/monitoror/models"
+
+	"github.com/jsdidierlaurent/echo-middleware/cache"
+	"github.com/labstack/echo/v4"
+)
+
+const defaultHistoryInterval = time.Minute
+
+type (
+	// HistoryMiddleware keep a rolling window of past values of tiles in store and return them in TileValue
+	HistoryMiddleware struct {
+		store   cache.Store
+		maxSize int
+
+		// lock around read / write of history in store
+		lock sync.Mutex
+	}
+
+	// history of one tile, sampled at most once by interval
+	history struct {
+		Values     []string
+		Timestamps []int64 // in Millisecond
+	}
+)
+
+// NewHistoryMiddleware used config to instantiate HistoryMiddleware
+func NewHistoryMiddleware(store cache.Store, maxSize int) *HistoryMiddleware {
+	return &HistoryMiddleware{store: store, maxSize: maxSize}
+}
+
+//HistoryHandler replace value of tile by its history when requested by tile. (Decorator Handlers)
+// See models.HistorySizeQueryParam and models.HistoryIntervalQueryParam
+func (hm *HistoryMiddleware) HistoryHandler(handle echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		size, err := strconv.Atoi(ctx.QueryParam(models.HistorySizeQueryParam))
+		if err != nil || size <= 0 {
+			return handle(ctx)
+		}
+		if hm.maxSize > 0 && size > hm.maxSize {
+			size = hm.maxSize
+		}
+
+		interval := defaultHistoryInterval
+		if value, err := strconv.Atoi(ctx.QueryParam(models.HistoryIntervalQueryParam)); err == nil && value > 0 {
+			interval = time.Duration(value) * time.Millisecond
+		}
+
+		key := fmt.Sprintf("%s:%s", models.HistoryStoreKeyPrefix, ctx.Request().URL.String())
+		return handleAndUpdateTile(ctx, handle, func(tile *models.Tile) bool {
+			return hm.addToHistory(key, tile, size, interval, time.Now())
+		})
+	}
+}
+
+// addToHistory add last value of tile in history and replace tile values by history.
+// Inside the same interval, the last sample is updated with the latest value
+func (hm *HistoryMiddleware) addToHistory(key string, tile *models.Tile, size int, interval time.Duration, now time.Time) bool {
+	if tile.Value == nil || len(tile.Value.Values) == 0 {
+		return false
+	}
+
+	hm.lock.Lock()
+	defer hm.lock.Unlock()
+
+	previous := history{}
+	_ = hm.store.Get(key, &previous)
+
+	value := tile.Value.Values[len(tile.Value.Values)-1]
+	timestamp := now.UnixNano() / int64(time.Millisecond)
+
+	// Copy history, slices are shared with store
+	current := history{
+		Values:     append([]string{}, previous.Values...),
+		Timestamps: append([]int64{}, previous.Timestamps...),
+	}
+
+	last := len(current.Timestamps) - 1
+	if last >= 0 && timestamp-current.Timestamps[last] < int64(interval/time.Millisecond) {
+		current.Values[last] = value
+	} else {
+		current.Values = append(current.Values, value)
+		current.Timestamps = append(current.Timestamps, timestamp)
+	}
+
+	if len(current.Values) > size {
+		current.Values = current.Values[len(current.Values)-size:]
+		current.Timestamps = current.Timestamps[len(current.Timestamps)-size:]
+	}
+
+	// Keep history at least one default interval after the end of window
+	expiration := interval
+	if expiration < defaultHistoryInterval {
+		expiration = defaultHistoryInterval
+	}
+	_ = hm.store.Set(key, current, expiration*time.Duration(size+1))
+
+	tile.Value.Values = current.Values
+	tile.Value.Timestamps = current.Timestamps
+
+	return true
+}
@@ -0,0 +1,85 @@
+package middlewares
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/monitoror/monitoror/models"
+
+	"github.com/jsdidierlaurent/echo-middleware/cache"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistoryHandler(t *testing.T) {
+	middleware := NewHistoryMiddleware(cache.NewGoCacheStore(time.Minute, time.Minute), 2)
+
+	value := 0
+	handler := middleware.HistoryHandler(func(c echo.Context) error {
+		value++
+		tile := newValueTile(models.SuccessStatus, []string{"1", "2", "3", "4"}[value-1])
+		return c.JSON(http.StatusOK, tile)
+	})
+
+	for _, expected := range [][]string{{"1"}, {"1", "2"}, {"2", "3"}, {"3", "4"}} {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/test?historySize=5&historyInterval=1", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		time.Sleep(time.Millisecond * 2)
+		if assert.NoError(t, handler(ctx)) {
+			tile := &models.Tile{}
+			assert.NoError(t, json.Unmarshal(res.Body.Bytes(), tile))
+			assert.Equal(t, expected, tile.Value.Values)
+			assert.Len(t, tile.Value.Timestamps, len(expected))
+		}
+	}
+}
+
+func TestHistoryHandler_WithoutHistory(t *testing.T) {
+	middleware := NewHistoryMiddleware(cache.NewGoCacheStore(time.Minute, time.Minute), 2)
+	handler := middleware.HistoryHandler(func(c echo.Context) error {
+		return c.JSON(http.StatusOK, newValueTile(models.SuccessStatus, "1"))
+	})
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	res := httptest.NewRecorder()
+	ctx := e.NewContext(req, res)
+
+	if assert.NoError(t, handler(ctx)) {
+		tile := &models.Tile{}
+		assert.NoError(t, json.Unmarshal(res.Body.Bytes(), tile))
+		assert.Equal(t, []string{"1"}, tile.Value.Values)
+		assert.Nil(t, tile.Value.Timestamps)
+	}
+}
+
+func TestHistoryMiddleware_AddToHistory(t *testing.T) {
+	middleware := NewHistoryMiddleware(cache.NewGoCacheStore(time.Minute, time.Minute), 0)
+	now := time.Unix(1000, 0)
+
+	for _, testcase := range []struct {
+		value              string
+		delay              time.Duration
+		expectedValues     []string
+		expectedTimestamps []int64
+	}{
+		{value: "10", delay: 0, expectedValues: []string{"10"}, expectedTimestamps: []int64{1000000}},
+		{value: "11", delay: time.Second * 30, expectedValues: []string{"11"}, expectedTimestamps: []int64{1000000}},
+		{value: "12", delay: time.Second * 60, expectedValues: []string{"11", "12"}, expectedTimestamps: []int64{1000000, 1060000}},
+		{value: "13", delay: time.Second * 150, expectedValues: []string{"11", "12", "13"}, expectedTimestamps: []int64{1000000, 1060000, 1150000}},
+		{value: "14", delay: time.Second * 250, expectedValues: []string{"12", "13", "14"}, expectedTimestamps: []int64{1060000, 1150000, 1250000}},
+	} {
+		tile := newValueTile(models.SuccessStatus, testcase.value)
+		assert.True(t, middleware.addToHistory("key", tile, 3, time.Minute, now.Add(testcase.delay)))
+		assert.Equal(t, testcase.expectedValues, tile.Value.Values)
+		assert.Equal(t, testcase.expectedTimestamps, tile.Value.Timestamps)
+	}
+
+	assert.False(t, middleware.addToHistory("key", models.NewTile("TEST"), 3, time.Minute, now))
+}
@@ -0,0 +1,49 @@
+package middlewares
+
+import (
+	"strconv"
+
+	"github.com/monitoror/monitoror/models"
+	"github.com/monitoror/monitoror/pkg/threshold"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ThresholdHandler change status of successful tile when its value match thresholds requested by tile. (Decorator Handlers)
+// See models.WarningThresholdQueryParam and models.FailureThresholdQueryParam
+func ThresholdHandler(handle echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		warning, _ := threshold.Parse(ctx.QueryParam(models.WarningThresholdQueryParam))
+		failure, _ := threshold.Parse(ctx.QueryParam(models.FailureThresholdQueryParam))
+		if warning == nil && failure == nil {
+			return handle(ctx)
+		}
+
+		return handleAndUpdateTile(ctx, handle, func(tile *models.Tile) bool {
+			return applyThresholds(tile, warning, failure)
+		})
+	}
+}
+
+// applyThresholds update status of successful tile using its last value. Return true when status is changed
+func applyThresholds(tile *models.Tile, warning, failure *threshold.Threshold) bool {
+	if tile.Status != models.SuccessStatus || tile.Value == nil || len(tile.Value.Values) == 0 {
+		return false
+	}
+
+	value, err := strconv.ParseFloat(tile.Value.Values[len(tile.Value.Values)-1], 64)
+	if err != nil {
+		return false
+	}
+
+	if failure != nil && failure.Match(value) {
+		tile.Status = models.FailedStatus
+		return true
+	}
+	if warning != nil && warning.Match(value) {
+		tile.Status = models.WarningStatus
+		return true
+	}
+
+	return false
+}
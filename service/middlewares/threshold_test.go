@@ -0,0 +1,71 @@
+package middlewares
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/monitoror/monitoror/models"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThresholdHandler(t *testing.T) {
+	for _, testcase := range []struct {
+		query          string
+		tile           *models.Tile
+		expectedStatus models.TileStatus
+	}{
+		{query: "", tile: newValueTile(models.SuccessStatus, "30"), expectedStatus: models.SuccessStatus},
+		{query: "?warningThreshold=>10&failureThreshold=>25", tile: newValueTile(models.SuccessStatus, "5"), expectedStatus: models.SuccessStatus},
+		{query: "?warningThreshold=>10&failureThreshold=>25", tile: newValueTile(models.SuccessStatus, "12"), expectedStatus: models.WarningStatus},
+		{query: "?warningThreshold=>10&failureThreshold=>25", tile: newValueTile(models.SuccessStatus, "1", "30"), expectedStatus: models.FailedStatus},
+		{query: "?failureThreshold=>0", tile: newValueTile(models.SuccessStatus, "1"), expectedStatus: models.FailedStatus},
+		{query: "?failureThreshold=>0", tile: newValueTile(models.SuccessStatus, "not a number"), expectedStatus: models.SuccessStatus},
+		{query: "?failureThreshold=>0", tile: newValueTile(models.RunningStatus, "1"), expectedStatus: models.RunningStatus},
+		{query: "?failureThreshold=>0", tile: &models.Tile{Type: "TEST", Status: models.SuccessStatus}, expectedStatus: models.SuccessStatus},
+		{query: "?failureThreshold=invalid", tile: newValueTile(models.SuccessStatus, "1"), expectedStatus: models.SuccessStatus},
+	} {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/test"+testcase.query, nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		handler := ThresholdHandler(func(c echo.Context) error {
+			return c.JSON(http.StatusOK, testcase.tile)
+		})
+
+		if assert.NoError(t, handler(ctx)) {
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			tile := &models.Tile{}
+			assert.NoError(t, json.Unmarshal(res.Body.Bytes(), tile))
+			assert.Equal(t, testcase.expectedStatus, tile.Status, testcase.query)
+		}
+	}
+}
+
+func TestThresholdHandler_WithError(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/test?failureThreshold=>0", nil)
+	res := httptest.NewRecorder()
+	ctx := e.NewContext(req, res)
+
+	handler := ThresholdHandler(func(c echo.Context) error {
+		return errors.New("boom")
+	})
+
+	assert.Error(t, handler(ctx))
+	assert.Equal(t, res, ctx.Response().Writer)
+	assert.False(t, ctx.Response().Committed)
+}
+
+func newValueTile(status models.TileStatus, values ...string) *models.Tile {
+	tile := models.NewTile("TEST").WithValue(models.NumberUnit)
+	tile.Status = status
+	tile.Value.Values = values
+	return tile
+}
@@ -0,0 +1,52 @@
+package middlewares
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/monitoror/monitoror/models"
+
+	"github.com/labstack/echo/v4"
+)
+
+type (
+	// bodyRecorder keep response body to update it before sending it
+	bodyRecorder struct {
+		http.ResponseWriter
+		code int
+		body bytes.Buffer
+	}
+)
+
+// handleAndUpdateTile call handle and let update change the returned tile before sending it.
+// update return true when tile is changed. Errors and non tile responses are sent as is
+func handleAndUpdateTile(ctx echo.Context, handle echo.HandlerFunc, update func(tile *models.Tile) bool) error {
+	writer := ctx.Response().Writer
+	recorder := &bodyRecorder{ResponseWriter: writer, code: http.StatusOK}
+	ctx.Response().Writer = recorder
+	err := handle(ctx)
+	ctx.Response().Writer = writer
+
+	if err != nil || !ctx.Response().Committed {
+		return err
+	}
+
+	body := recorder.body.Bytes()
+	tile := &models.Tile{}
+	if recorder.code == http.StatusOK && json.Unmarshal(body, tile) == nil && update(tile) {
+		body, _ = json.Marshal(tile)
+	}
+
+	writer.WriteHeader(recorder.code)
+	_, err = writer.Write(body)
+	return err
+}
+
+func (r *bodyRecorder) WriteHeader(code int) {
+	r.code = code
+}
+
+func (r *bodyRecorder) Write(b []byte) (int, error) {
+	return r.body.Write(b)
+}
@@ -36,3 +36,26 @@ func (_m *MonitorableRouterGroup) GET(path string, handlerFunc echo.HandlerFunc,
 
 	return r0
 }
+
+// POST provides a mock function with given fields: path, handlerFunc, _a2
+func (_m *MonitorableRouterGroup) POST(path string, handlerFunc echo.HandlerFunc, _a2 ...options.RouterOption) *echo.Route {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, path, handlerFunc)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *echo.Route
+	if rf, ok := ret.Get(0).(func(string, echo.HandlerFunc, ...options.RouterOption) *echo.Route); ok {
+		r0 = rf(path, handlerFunc, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*echo.Route)
+		}
+	}
+
+	return r0
+}
@@ -18,11 +18,13 @@ type (
 	}
 	MonitorableRouterGroup interface {
 		GET(path string, handlerFunc echo.HandlerFunc, options ...options.RouterOption) *echo.Route
+		POST(path string, handlerFunc echo.HandlerFunc, options ...options.RouterOption) *echo.Route
 	}
 
 	router struct {
-		apiVersion      *echo.Group
-		cacheMiddleware *middlewares.CacheMiddleware
+		apiVersion        *echo.Group
+		cacheMiddleware   *middlewares.CacheMiddleware
+		historyMiddleware *middlewares.HistoryMiddleware
 	}
 
 	group struct {
@@ -31,8 +33,8 @@ type (
 	}
 )
 
-func NewMonitorableRouter(apiVersion *echo.Group, cacheMiddleware *middlewares.CacheMiddleware) MonitorableRouter {
-	return &router{apiVersion: apiVersion, cacheMiddleware: cacheMiddleware}
+func NewMonitorableRouter(apiVersion *echo.Group, cacheMiddleware *middlewares.CacheMiddleware, historyMiddleware *middlewares.HistoryMiddleware) MonitorableRouter {
+	return &router{apiVersion: apiVersion, cacheMiddleware: cacheMiddleware, historyMiddleware: historyMiddleware}
 }
 
 func (r *router) Group(path string, variantName coreModels.VariantName) MonitorableRouterGroup {
@@ -51,5 +53,16 @@ func (g *group) GET(path string, handlerFunc echo.HandlerFunc, opts ...options.R
 		}
 	}
 
+	// History and thresholds are evaluated on every response, cached or not
+	handler = g.router.historyMiddleware.HistoryHandler(handler)
+	handler = middlewares.ThresholdHandler(handler)
+
 	return g.group.GET(path, handler, routerSettings.Middlewares...)
 }
+
+// POST register a route receiving data from external systems. Cache, history and thresholds aren't used on POST routes
+func (g *group) POST(path string, handlerFunc echo.HandlerFunc, opts ...options.RouterOption) *echo.Route {
+	routerSettings := options.ApplyOptions(opts...)
+
+	return g.group.POST(path, handlerFunc, routerSettings.Middlewares...)
+}
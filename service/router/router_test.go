@@ -17,8 +17,9 @@ import (
 func TestNewMonitorableRouter(t *testing.T) {
 	// Init
 	g := echo.New().Group("/api/v1")
-	cacheMiddleware := middlewares.NewCacheMiddleware(cache.NewGoCacheStore(time.Minute, time.Second), time.Minute, time.Minute)
-	monitorableRouter := NewMonitorableRouter(g, cacheMiddleware)
+	cacheMiddleware := middlewares.NewCacheMiddleware(cache.NewGoCacheStore(time.Minute, time.Second), time.Minute, time.Minute, time.Second, time.Hour)
+	historyMiddleware := middlewares.NewHistoryMiddleware(cache.NewGoCacheStore(time.Minute, time.Second), 10)
+	monitorableRouter := NewMonitorableRouter(g, cacheMiddleware, historyMiddleware)
 	handler := func(context echo.Context) error { return nil }
 
 	routeGroup := monitorableRouter.Group("/test", coreModels.DefaultVariantName)
@@ -31,4 +32,8 @@ func TestNewMonitorableRouter(t *testing.T) {
 	assert.Equal(t, "/api/v1/test/default/test2", test2.Path)
 	assert.Equal(t, "/api/v1/test/default/test3", test3.Path)
 	assert.Equal(t, "/api/v1/test/default/test4", test4.Path)
+
+	test5 := routeGroup.POST("/test5", handler, options.WithMiddlewares(echoMiddleware.BodyLimit("1K")))
+	assert.Equal(t, "/api/v1/test/default/test5", test5.Path)
+	assert.Equal(t, echo.POST, test5.Method)
 }
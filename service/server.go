@@ -22,6 +22,8 @@ type (
 
 		// CacheMiddleware using CacheStore to return cached data
 		CacheMiddleware *middlewares.CacheMiddleware
+		// HistoryMiddleware using CacheStore to keep past values of tiles
+		HistoryMiddleware *middlewares.HistoryMiddleware
 
 		store *store.Store
 	}
@@ -76,9 +78,14 @@ func (s *Server) setupEchoMiddleware() {
 	s.CacheMiddleware = middlewares.NewCacheMiddleware(s.store.CacheStore,
 		time.Millisecond*time.Duration(s.store.CoreConfig.DownstreamCacheExpiration),
 		time.Millisecond*time.Duration(s.store.CoreConfig.UpstreamCacheExpiration),
+		time.Millisecond*time.Duration(s.store.CoreConfig.MinTileCacheExpiration),
+		time.Millisecond*time.Duration(s.store.CoreConfig.MaxTileCacheExpiration),
 	) // Used as Handler wrapper in routes
 	s.Use(s.CacheMiddleware.DownstreamStoreMiddleware())
 
+	// History
+	s.HistoryMiddleware = middlewares.NewHistoryMiddleware(s.store.CacheStore, s.store.CoreConfig.MaxTileHistorySize) // Used as Handler wrapper in routes
+
 	// CORS
 	s.Use(echoMiddleware.CORSWithConfig(echoMiddleware.CORSConfig{
 		AllowOrigins: []string{"*"},
@@ -2,8 +2,12 @@ export enum TileType {
   HttpStatus = 'HTTP-STATUS',
   HttpRaw = 'HTTP-RAW',
   HttpFormatted = 'HTTP-FORMATTED',
+  HttpLatency = 'HTTP-LATENCY',
   Ping = 'PING',
   Port = 'PORT',
+  Dns = 'DNS',
+  Script = 'SCRIPT',
+  TlsCertificate = 'TLS-CERTIFICATE',
   PingdomCheck = 'PINGDOM-CHECK',
   PingdomTransactionCheck = 'PINGDOM-TRANSACTION-CHECK',
   GitHubChecks = 'GITHUB-CHECKS',
@@ -16,9 +20,12 @@ export enum TileType {
   Jenkins = 'JENKINS-BUILD',
   AzureDevOpsBuild = 'AZUREDEVOPS-BUILD',
   AzureDevOpsRelease = 'AZUREDEVOPS-RELEASE',
+  Webhook = 'WEBHOOK',
+  Heartbeat = 'HEARTBEAT',
 
   Empty = 'EMPTY',
   Group = 'GROUP',
+  Summary = 'SUMMARY',
 }
 
 export default TileType
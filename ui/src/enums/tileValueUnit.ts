@@ -1,7 +1,14 @@
 enum TileValueUnit {
   Millisecond = 'MILLISECOND',
+  Second = 'SECOND',
   Ratio = 'RATIO',
+  Percent = 'PERCENT',
   Number = 'NUMBER',
+  Bytes = 'BYTES',
+  BinaryBytes = 'BINARY_BYTES',
+  Currency = 'CURRENCY',
+  PerSecond = 'PER_SECOND',
+  PerMinute = 'PER_MINUTE',
   Raw = 'RAW',
 }
 
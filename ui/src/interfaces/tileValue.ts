@@ -3,4 +3,9 @@ import TileValueUnit from '@/enums/tileValueUnit'
 export default interface TileValue {
   values: string[],
   unit: TileValueUnit,
+  timestamps?: number[],
+  precision?: number,
+  prefix?: string,
+  suffix?: string,
+  currency?: string,
 }
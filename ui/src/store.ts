@@ -335,11 +335,12 @@ const store: StoreOptions<RootState> = {
         executor: async () => {
           await dispatch('refreshTile', tile)
 
-          if (groupTile !== undefined) {
+          // Aggregated groups have their own url, their state is computed by the core
+          if (groupTile !== undefined && !groupTile.url) {
             await dispatch('refreshGroup', groupTile)
           }
         },
-        interval: 10 * TaskInterval.Second,
+        interval: tile.refreshInterval || 10 * TaskInterval.Second,
         initialDelay: Math.random() * (tile.initialMaxDelay || 0),
       })
     },